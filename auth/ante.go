@@ -93,14 +93,39 @@ func NewAnteHandler(
 		// get account params
 		params := ak.GetParams(ctx)
 
-		// gas for tx
-		gasForTx := params.MaxTxGas // stdTx.Fee.Gas
+		// batch txs are limited by the params
+		msgCount := uint64(len(stdTx.GetMsgs()))
+		if msgCount > params.GetMaxTxMsgs() {
+			newCtx = SetGasMeter(simulate, ctx, 0)
+			return newCtx, sdk.ErrUnknownRequest(fmt.Sprintf("too many msgs in tx: %d, max %d", msgCount, params.GetMaxTxMsgs())).Result(), true
+		}
+
+		if msgCount > 1 {
+			if ctx.BlockHeight() < helper.GetBatchTxHeight() {
+				newCtx = SetGasMeter(simulate, ctx, 0)
+				return newCtx, sdk.ErrUnknownRequest("batch txs are not activated yet").Result(), true
+			}
+
+			// validators vote on side txs as a whole, so side-tx msgs are never batched
+			for _, msg := range stdTx.GetMsgs() {
+				if _, ok := msg.(types.SideTxMsg); ok {
+					newCtx = SetGasMeter(simulate, ctx, 0)
+					return newCtx, sdk.ErrUnknownRequest(fmt.Sprintf("side-tx msg %s can not be batched", msg.Type())).Result(), true
+				}
+			}
+		}
+
+		// gas for tx, each msg of a batch gets the gas of a single msg tx
+		gasForTx := params.MaxTxGas * msgCount // stdTx.Fee.Gas
 
 		amount, ok := sdk.NewIntFromString(params.TxFees)
 		if !ok {
 			return newCtx, sdk.ErrInternal("Invalid param tx fees").Result(), true
 		}
 
+		// each msg of a batch pays the fees of a single msg tx
+		amount = amount.MulRaw(int64(msgCount))
+
 		feeForTx := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: amount}} // stdTx.Fee.Amount
 
 		// new gas meter
@@ -326,7 +351,7 @@ func GetSignBytes(ctx sdk.Context, chainID string, stdTx authTypes.StdTx, acc au
 		accNum = acc.GetAccountNumber()
 	}
 
-	signBytes := authTypes.StdSignBytes(chainID, accNum, acc.GetSequence(), stdTx.Msg, stdTx.Memo)
+	signBytes := authTypes.StdBatchSignBytes(chainID, accNum, acc.GetSequence(), stdTx.GetMsgs(), stdTx.Memo)
 
 	if ctx.BlockHeight() > helper.GetNewHexToStringAlgoHeight() {
 		return signBytes
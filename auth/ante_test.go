@@ -15,6 +15,7 @@ import (
 	"github.com/maticnetwork/heimdall/auth/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/helper"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
 )
@@ -259,6 +260,47 @@ func (suite *AnteTestSuite) TestAccountNumbers() {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+func (suite *AnteTestSuite) TestBatchTx() {
+	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
+
+	// keys and addresses
+	priv1, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := happ.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr1))
+	err := acc1.SetCoins(simulation.RandomFeeCoins())
+	require.NoError(t, err)
+	require.NoError(t, acc1.SetAccountNumber(0))
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+
+	msg := sdkAuth.NewTestMsg(addr1)
+	msgs := []sdk.Msg{msg, msg, msg}
+
+	// batch txs are rejected by default
+	tx := types.NewTestBatchTx(ctx, msgs, priv1, uint64(0), uint64(0))
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownRequest)
+
+	params := happ.AccountKeeper.GetParams(ctx)
+	params.MaxTxMsgs = 3
+	happ.AccountKeeper.SetParams(ctx, params)
+
+	// each msg gets the gas of a single msg tx
+	_, result, _ := checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, params.MaxTxGas*3, result.GasWanted)
+
+	// the whole batch is signed
+	tx = types.NewTestBatchTx(ctx, msgs[:2], priv1, uint64(0), uint64(1))
+	tx = types.NewBatchStdTx(msgs, tx.(types.StdTx).Signature, "")
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// side-tx msgs are voted on as a whole tx, so they are never batched
+	from := hmTypes.AccAddressToHeimdallAddress(addr1)
+	topup := topupTypes.NewMsgTopup(from, from, sdk.NewInt(1), hmTypes.HexToHeimdallHash("0x01"), 0, 1)
+
+	tx = types.NewTestBatchTx(ctx, []sdk.Msg{topup, msg}, priv1, uint64(0), uint64(1))
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownRequest)
+}
+
 // Test logic around account number checking with many signers when BlockHeight is 0.
 func (suite *AnteTestSuite) TestAccountNumbersAtBlockHeightZero() {
 	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
@@ -223,9 +223,12 @@ func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	ak.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. MaxTxMsgs was added after
+// genesis and keeps its default value until it is set.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	ak.paramSubspace.GetParamSetIfExists(ctx, &params)
+
 	return
 }
 
@@ -163,9 +163,13 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 		happ := app.Setup(true)
 		ctx := happ.BaseApp.NewContext(true, abci.Header{})
 		querier := auth.NewQuerier(happ.AccountKeeper)
-		require.Panics(t, func() {
-			_, err = querier(ctx, path, req)
-			require.NoError(t, err)
-		})
+
+		// params which aren't set keep their default values
+		res, err := querier(ctx, path, req)
+		require.NoError(t, err)
+
+		var unsetParams types.Params
+		require.NoError(t, jsoniter.ConfigFastest.Unmarshal(res, &unsetParams))
+		require.Equal(t, types.DefaultParams(), unsetParams)
 	}
 }
@@ -127,6 +127,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 		maxTxGas,
 		txFees,
+		types.DefaultMaxTxMsgs,
 	)
 	genesisAccs := RandomGenesisAccounts(simState)
 
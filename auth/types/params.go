@@ -19,6 +19,9 @@ const (
 
 	DefaultMaxTxGas uint64 = 1000000
 	DefaultTxFees   string = "1000000000000000"
+
+	// batch txs are disabled until the limit is raised by governance
+	DefaultMaxTxMsgs uint64 = 1
 )
 
 // Parameter keys
@@ -29,8 +32,9 @@ var (
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
 
-	KeyMaxTxGas = []byte("MaxTxGas")
-	KeyTxFees   = []byte("TxFees")
+	KeyMaxTxGas  = []byte("MaxTxGas")
+	KeyTxFees    = []byte("TxFees")
+	KeyMaxTxMsgs = []byte("MaxTxMsgs")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -45,6 +49,9 @@ type Params struct {
 
 	MaxTxGas uint64 `json:"max_tx_gas" yaml:"max_tx_gas"`
 	TxFees   string `json:"tx_fees" yaml:"tx_fees"`
+
+	// max number of msgs in a batch tx, 0 means the default
+	MaxTxMsgs uint64 `json:"max_tx_msgs" yaml:"max_tx_msgs"`
 }
 
 // NewParams creates a new Params object
@@ -57,6 +64,7 @@ func NewParams(
 
 	maxTxGas uint64,
 	txFees string,
+	maxTxMsgs uint64,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -65,8 +73,9 @@ func NewParams(
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
 
-		MaxTxGas: maxTxGas,
-		TxFees:   txFees,
+		MaxTxGas:  maxTxGas,
+		TxFees:    txFees,
+		MaxTxMsgs: maxTxMsgs,
 	}
 }
 
@@ -88,6 +97,7 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 
 		{KeyMaxTxGas, &p.MaxTxGas},
 		{KeyTxFees, &p.TxFees},
+		{KeyMaxTxMsgs, &p.MaxTxMsgs},
 	}
 }
 
@@ -108,8 +118,9 @@ func DefaultParams() Params {
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
 
-		MaxTxGas: DefaultMaxTxGas,
-		TxFees:   DefaultTxFees,
+		MaxTxGas:  DefaultMaxTxGas,
+		TxFees:    DefaultTxFees,
+		MaxTxMsgs: DefaultMaxTxMsgs,
 	}
 }
 
@@ -125,6 +136,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
 	sb.WriteString(fmt.Sprintf("MaxTxGas: %d\n", p.MaxTxGas))
 	sb.WriteString(fmt.Sprintf("TxFees: %s\n", p.TxFees))
+	sb.WriteString(fmt.Sprintf("MaxTxMsgs: %d\n", p.MaxTxMsgs))
 
 	return sb.String()
 }
@@ -206,6 +218,15 @@ func validateTxFees(v string) error {
 	return nil
 }
 
+// GetMaxTxMsgs returns the max number of msgs in a tx
+func (p Params) GetMaxTxMsgs() uint64 {
+	if p.MaxTxMsgs == 0 {
+		return DefaultMaxTxMsgs
+	}
+
+	return p.MaxTxMsgs
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
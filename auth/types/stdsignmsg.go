@@ -14,23 +14,34 @@ import (
 // and the Sequence numbers for each signature (prevent
 // inchain replay and enforce tx ordering per account).
 type StdSignDoc struct {
-	ChainID       string          `json:"chain_id" yaml:"chain_id"`
-	AccountNumber uint64          `json:"account_number" yaml:"account_number"`
-	Sequence      uint64          `json:"sequence" yaml:"sequence"`
-	Msg           json.RawMessage `json:"msg" yaml:"msg"`
-	Memo          string          `json:"memo" yaml:"memo"`
+	ChainID       string            `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64            `json:"account_number" yaml:"account_number"`
+	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	Msg           json.RawMessage   `json:"msg" yaml:"msg"`
+	Memo          string            `json:"memo" yaml:"memo"`
+	Msgs          []json.RawMessage `json:"msgs,omitempty" yaml:"msgs,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum uint64, sequence uint64, msg sdk.Msg, memo string) []byte {
-	msgsBytes := json.RawMessage(msg.GetSignBytes())
+	return StdBatchSignBytes(chainID, accnum, sequence, []sdk.Msg{msg}, memo)
+}
+
+// StdBatchSignBytes returns the bytes to sign for a transaction with one or
+// more msgs. They are the same as StdSignBytes for a single msg.
+func StdBatchSignBytes(chainID string, accnum uint64, sequence uint64, msgs []sdk.Msg, memo string) []byte {
+	var batchMsgsBytes []json.RawMessage
+	for _, msg := range msgs[1:] {
+		batchMsgsBytes = append(batchMsgsBytes, json.RawMessage(msg.GetSignBytes()))
+	}
 
 	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
 		AccountNumber: accnum,
 		ChainID:       chainID,
 		Memo:          memo,
-		Msg:           msgsBytes,
+		Msg:           json.RawMessage(msgs[0].GetSignBytes()),
 		Sequence:      sequence,
+		Msgs:          batchMsgsBytes,
 	})
 	if err != nil {
 		panic(err)
@@ -43,14 +54,20 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, msg sdk.Msg, m
 // a Msg with the other requirements for a StdSignDoc before
 // it is signed. For use in the CLI.
 type StdSignMsg struct {
-	ChainID       string  `json:"chain_id" yaml:"chain_id"`
-	AccountNumber uint64  `json:"account_number" yaml:"account_number"`
-	Sequence      uint64  `json:"sequence" yaml:"sequence"`
-	Msg           sdk.Msg `json:"msg" yaml:"msg"`
-	Memo          string  `json:"memo" yaml:"memo"`
+	ChainID       string    `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64    `json:"account_number" yaml:"account_number"`
+	Sequence      uint64    `json:"sequence" yaml:"sequence"`
+	Msg           sdk.Msg   `json:"msg" yaml:"msg"`
+	Memo          string    `json:"memo" yaml:"memo"`
+	Msgs          []sdk.Msg `json:"msgs,omitempty" yaml:"msgs,omitempty"`
+}
+
+// GetMsgs returns all the msgs to sign
+func (msg StdSignMsg) GetMsgs() []sdk.Msg {
+	return append([]sdk.Msg{msg.Msg}, msg.Msgs...)
 }
 
 // Bytes returns message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Msg, msg.Memo)
+	return StdBatchSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.GetMsgs(), msg.Memo)
 }
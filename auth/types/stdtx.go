@@ -15,10 +15,12 @@ var (
 )
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
+// A batch tx carries its other msgs in Msgs, they run after Msg.
 type StdTx struct {
 	Msg       sdk.Msg      `json:"msg" yaml:"msg"`
 	Signature StdSignature `json:"signature" yaml:"signature"`
 	Memo      string       `json:"memo" yaml:"memo"`
+	Msgs      []sdk.Msg    `json:"msgs,omitempty" yaml:"msgs,omitempty" rlp:"optional"`
 }
 
 // StdTxRaw is a standard way to wrap a RLP Msg with Fee and Signatures.
@@ -37,9 +39,19 @@ func NewStdTx(msg sdk.Msg, sig StdSignature, memo string) StdTx {
 	}
 }
 
+// NewBatchStdTx returns a tx with all the given msgs
+func NewBatchStdTx(msgs []sdk.Msg, sig StdSignature, memo string) StdTx {
+	tx := NewStdTx(msgs[0], sig, memo)
+	if len(msgs) > 1 {
+		tx.Msgs = msgs[1:]
+	}
+
+	return tx
+}
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg {
-	return []sdk.Msg{tx.Msg}
+	return append([]sdk.Msg{tx.Msg}, tx.Msgs...)
 }
 
 // ValidateBasic does a simple and lightweight validation check that doesn't
@@ -55,6 +67,13 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 		return sdk.ErrUnauthorized("wrong number of signers")
 	}
 
+	// a batch only holds msgs of the same type
+	for _, msg := range tx.Msgs {
+		if msg == nil || msg.Route() != tx.Msg.Route() || msg.Type() != tx.Msg.Type() {
+			return sdk.ErrUnknownRequest("batch tx msgs must be of the same type")
+		}
+	}
+
 	return nil
 }
 
@@ -98,3 +98,51 @@ func TestTxHash(t *testing.T) {
 
 	require.Equal(t, txHashStr, hex.EncodeToString(tx.Hash()))
 }
+
+type legacyStdTx struct {
+	Msg       sdk.Msg      `json:"msg" yaml:"msg"`
+	Signature StdSignature `json:"signature" yaml:"signature"`
+	Memo      string       `json:"memo" yaml:"memo"`
+}
+
+type otherTestMsg struct {
+	*sdk.TestMsg
+}
+
+func (msg otherTestMsg) Type() string { return "Other" }
+
+func TestBatchStdTx(t *testing.T) {
+	t.Parallel()
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+
+	legacyCdc := codec.New()
+	sdk.RegisterCodec(legacyCdc)
+	legacyCdc.RegisterConcrete(legacyStdTx{}, "auth/StdTx", nil)
+	legacyCdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+
+	msg := sdk.NewTestMsg(addr)
+	sig := StdSignature([]byte{1})
+
+	// a single msg tx is encoded and signed as before
+	tx := NewBatchStdTx([]sdk.Msg{msg}, sig, "memo")
+	require.Nil(t, tx.Msgs)
+	require.Equal(t, cdc.MustMarshalBinaryLengthPrefixed(tx), legacyCdc.MustMarshalBinaryLengthPrefixed(legacyStdTx{Msg: msg, Signature: sig, Memo: "memo"}))
+	require.NotContains(t, string(StdSignBytes("chain", 1, 2, msg, "memo")), "msgs")
+
+	batchTx := NewBatchStdTx([]sdk.Msg{msg, msg, msg}, sig, "")
+	require.Len(t, batchTx.GetMsgs(), 3)
+	require.Equal(t, []sdk.AccAddress{addr}, batchTx.GetSigners())
+	require.Nil(t, batchTx.ValidateBasic())
+	require.Contains(t, string(StdBatchSignBytes("chain", 1, 2, batchTx.GetMsgs(), "")), "msgs")
+
+	var decoded StdTx
+	require.NoError(t, cdc.UnmarshalBinaryLengthPrefixed(cdc.MustMarshalBinaryLengthPrefixed(batchTx), &decoded))
+	require.Len(t, decoded.GetMsgs(), 3)
+
+	mixedTx := NewBatchStdTx([]sdk.Msg{msg, otherTestMsg{msg}}, sig, "")
+	require.NotNil(t, mixedTx.ValidateBasic())
+}
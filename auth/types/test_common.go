@@ -33,3 +33,17 @@ func NewTestTxWithMemo(ctx sdk.Context, msg sdk.Msg, priv crypto.PrivKey, accNum
 
 	return tx
 }
+
+// NewTestBatchTx create new test tx with several msgs
+func NewTestBatchTx(ctx sdk.Context, msgs []sdk.Msg, priv crypto.PrivKey, accNum uint64, seq uint64) sdk.Tx {
+	signBytes := StdBatchSignBytes(ctx.ChainID(), accNum, seq, msgs, "")
+
+	sig, err := priv.Sign(signBytes)
+	if err != nil {
+		panic(err)
+	}
+
+	tx := NewBatchStdTx(msgs, sig, "")
+
+	return tx
+}
@@ -14,6 +14,12 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
+// HashSigner signs the keccak256 hash of the sign bytes, returning a 65 bytes
+// [R || S || V] signature. It lets the key live outside the node process.
+type HashSigner interface {
+	SignHash(hash []byte) ([]byte, error)
+}
+
 // TxBuilder implements a transaction context created in SDK modules.
 type TxBuilder struct {
 	txEncoder          sdk.TxEncoder
@@ -192,7 +198,8 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
-		Msg:           msgs[0], // allow only one message
+		Msg:           msgs[0],
+		Msgs:          msgs[1:],
 	}, nil
 }
 
@@ -203,7 +210,7 @@ func (bldr TxBuilder) Sign(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) (
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+	return bldr.txEncoder(NewBatchStdTx(msg.GetMsgs(), sig, msg.Memo))
 }
 
 // SignWithPassphrase signs a transaction given a name, passphrase, and a single message to
@@ -214,7 +221,7 @@ func (bldr TxBuilder) SignWithPassphrase(name, passphrase string, msg StdSignMsg
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+	return bldr.txEncoder(NewBatchStdTx(msg.GetMsgs(), sig, msg.Memo))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -228,6 +235,22 @@ func (bldr TxBuilder) BuildAndSign(privKey secp256k1.PrivKeySecp256k1, msgs []sd
 	return bldr.Sign(privKey, stdMsg)
 }
 
+// BuildAndSignWithSigner builds a single message to be signed, and signs a
+// transaction with the built message using the given signer.
+func (bldr TxBuilder) BuildAndSignWithSigner(signer HashSigner, msgs []sdk.Msg) ([]byte, error) {
+	stdMsg, err := bldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := MakeSignatureWithSigner(signer, stdMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.txEncoder(NewBatchStdTx(stdMsg.GetMsgs(), sig, stdMsg.Memo))
+}
+
 // BuildAndSignWithPassphrase builds a single message to be signed, and signs a transaction
 // with the built message given a name, passphrase, and a set of messages.
 func (bldr TxBuilder) BuildAndSignWithPassphrase(name, passphrase string, msgs []sdk.Msg) ([]byte, error) {
@@ -250,7 +273,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 	// the ante handler will populate with a sentinel pubkey
 	sig := StdSignature{}
 
-	return bldr.txEncoder(NewStdTx(signMsg.Msg, sig, signMsg.Memo))
+	return bldr.txEncoder(NewBatchStdTx(signMsg.GetMsgs(), sig, signMsg.Memo))
 }
 
 // SignStdTxWithPassphrase appends a signature to a StdTx and returns a copy of it. If append
@@ -264,14 +287,15 @@ func (bldr TxBuilder) SignStdTxWithPassphrase(name, passphrase string, stdTx Std
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
-		Msg:           stdTx.GetMsgs()[0],
+		Msg:           stdTx.Msg,
 		Memo:          stdTx.GetMemo(),
+		Msgs:          stdTx.Msgs,
 	})
 	if err != nil {
 		return
 	}
 
-	signedStdTx = NewStdTx(stdTx.GetMsgs()[0], stdSignature, stdTx.GetMemo())
+	signedStdTx = NewBatchStdTx(stdTx.GetMsgs(), stdSignature, stdTx.GetMemo())
 
 	return
 }
@@ -288,7 +312,8 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          stdTx.Memo,
-		Msg:           stdTx.Msg, // allow only one message
+		Msg:           stdTx.Msg,
+		Msgs:          stdTx.Msgs,
 	}
 
 	sig, err := MakeSignature(privKey, signMsg)
@@ -296,7 +321,33 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 		return
 	}
 
-	signedStdTx = NewStdTx(signMsg.Msg, sig, signMsg.Memo)
+	signedStdTx = NewBatchStdTx(signMsg.GetMsgs(), sig, signMsg.Memo)
+
+	return
+}
+
+// SignStdTxWithSigner is SignStdTx using the given signer instead of a
+// private key.
+func (bldr TxBuilder) SignStdTxWithSigner(signer HashSigner, stdTx StdTx, appendSig bool) (signedStdTx StdTx, err error) {
+	if bldr.chainID == "" {
+		return StdTx{}, fmt.Errorf("chain ID required but not specified")
+	}
+
+	signMsg := StdSignMsg{
+		ChainID:       bldr.chainID,
+		AccountNumber: bldr.accountNumber,
+		Sequence:      bldr.sequence,
+		Memo:          stdTx.Memo,
+		Msg:           stdTx.Msg,
+		Msgs:          stdTx.Msgs,
+	}
+
+	sig, err := MakeSignatureWithSigner(signer, signMsg)
+	if err != nil {
+		return
+	}
+
+	signedStdTx = NewBatchStdTx(signMsg.GetMsgs(), sig, signMsg.Memo)
 
 	return
 }
@@ -312,6 +363,13 @@ func MakeSignature(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) (sig StdS
 	return ethCrypto.Sign(data, privKey[:])
 }
 
+// MakeSignatureWithSigner builds a StdSignature for given a StdSignMsg using
+// the given signer.
+func MakeSignatureWithSigner(signer HashSigner, msg StdSignMsg) (sig StdSignature, err error) {
+	data := crypto.Keccak256(msg.Bytes())
+	return signer.SignHash(data)
+}
+
 // RecoverPubkey builds a StdSignature for given a StdSignMsg.
 func RecoverPubkey(msg []byte, sig []byte) ([]byte, error) {
 	data := crypto.Keccak256(msg)
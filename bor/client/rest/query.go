@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -128,7 +129,10 @@ type spanSeed struct {
 	Result string `json:"result"`
 }
 
-var spanOverrides map[uint64]*HeimdallSpanResultWithHeight = nil
+var (
+	spanOverrides     map[uint64]*HeimdallSpanResultWithHeight
+	spanOverridesOnce sync.Once
+)
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bor/span/list", spanListHandlerFn(cliCtx)).Methods("GET")
@@ -276,11 +280,7 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			spanOverridden bool
 		)
 
-		if spanOverrides == nil {
-			loadSpanOverrides()
-		}
-
-		if span, ok := spanOverrides[spanID]; ok {
+		if span, ok := GetSpanOverride(spanID); ok {
 			res = span.Result
 			height = span.Height
 			spanOverridden = true
@@ -532,6 +532,16 @@ type ResponseWithHeight struct {
 	Result jsoniter.RawMessage `json:"result"`
 }
 
+// GetSpanOverride returns the hard-coded span for the given id, if the current
+// chain has one. Overridden spans must be served instead of the stored ones.
+func GetSpanOverride(spanID uint64) (*HeimdallSpanResultWithHeight, bool) {
+	spanOverridesOnce.Do(loadSpanOverrides)
+
+	span, ok := spanOverrides[spanID]
+
+	return span, ok
+}
+
 func loadSpanOverrides() {
 	spanOverrides = map[uint64]*HeimdallSpanResultWithHeight{}
 
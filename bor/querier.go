@@ -8,6 +8,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/common"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -88,16 +89,16 @@ func handleQuerySpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
+	// return error if span doesn't exist
+	if !keeper.HasSpan(ctx, params.RecordID) {
+		return nil, common.ErrSpanNotFound(keeper.Codespace())
+	}
+
 	span, err := keeper.GetSpan(ctx, params.RecordID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span", err.Error()))
 	}
 
-	// return error if span doesn't exist
-	if span == nil {
-		return nil, sdk.ErrInternal(fmt.Sprintf("span %v does not exist", params.RecordID))
-	}
-
 	// json record
 	bz, err := jsoniter.ConfigFastest.Marshal(span)
 	if err != nil {
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// healthReport is the bridge state served on the health endpoints
+type healthReport struct {
+	Ready       bool                   `json:"ready"`
+	Reasons     []string               `json:"reasons,omitempty"`
+	Listeners   []util.ListenerHealth  `json:"listeners"`
+	Processors  []util.ProcessorHealth `json:"processors"`
+	Queue       queueHealth            `json:"queue"`
+	Broadcaster broadcasterHealth      `json:"broadcaster"`
+}
+
+type queueHealth struct {
+	Depth int    `json:"depth"`
+	Error string `json:"error,omitempty"`
+}
+
+type broadcasterHealth struct {
+	LocalSequence   uint64 `json:"local_sequence"`
+	AccountSequence uint64 `json:"account_sequence"`
+	SequenceDrift   int64  `json:"sequence_drift"`
+	Error           string `json:"error,omitempty"`
+}
+
+var healthHandlersOnce sync.Once
+
+// registerHealthHandlers serves the bridge health on the metrics server.
+// /health always answers 200 while the bridge runs, /ready answers 503 until
+// all listeners follow their chain, and the queue and heimdall are reachable.
+func registerHealthHandlers(queueConnector *queue.QueueConnector, txBroadcaster *broadcaster.TxBroadcaster) {
+	healthHandlersOnce.Do(func() {
+		http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+			writeHealthReport(w, newHealthReport(queueConnector, txBroadcaster), http.StatusOK)
+		})
+
+		http.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+			report := newHealthReport(queueConnector, txBroadcaster)
+
+			status := http.StatusOK
+			if !report.Ready {
+				status = http.StatusServiceUnavailable
+			}
+
+			writeHealthReport(w, report, status)
+		})
+	})
+}
+
+func newHealthReport(queueConnector *queue.QueueConnector, txBroadcaster *broadcaster.TxBroadcaster) healthReport {
+	report := healthReport{
+		Listeners:  util.ListenersHealth(),
+		Processors: util.ProcessorsHealth(),
+	}
+
+	report.Reasons = util.ListenersNotReady(report.Listeners)
+
+	depth, err := queueConnector.QueueDepth()
+	if err != nil {
+		report.Queue.Error = err.Error()
+		report.Reasons = append(report.Reasons, "queue is not reachable")
+	}
+
+	report.Queue.Depth = depth
+
+	localSeqNo, accountSeqNo, err := txBroadcaster.SequenceDrift()
+	if err != nil {
+		report.Broadcaster.Error = err.Error()
+		report.Reasons = append(report.Reasons, "heimdall account is not reachable")
+	} else {
+		report.Broadcaster.AccountSequence = accountSeqNo
+		report.Broadcaster.SequenceDrift = int64(localSeqNo) - int64(accountSeqNo)
+	}
+
+	report.Broadcaster.LocalSequence = localSeqNo
+	report.Ready = len(report.Reasons) == 0
+
+	return report
+}
+
+func writeHealthReport(w http.ResponseWriter, report healthReport, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logger.Error("Error while writing health report", "error", err)
+	}
+}
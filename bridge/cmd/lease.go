@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/lease"
+)
+
+// errLeaseLost is returned when the bridge stopped because it lost the lease
+var errLeaseLost = errors.New("bridge lease lost, another instance took over")
+
+// acquireLease blocks until this bridge instance holds the lease. It returns
+// nil if high availability is disabled.
+func acquireLease(ctx context.Context) (lease.Lease, error) {
+	bridgeLease, err := lease.NewLease()
+	if err != nil || bridgeLease == nil {
+		return nil, err
+	}
+
+	logger.Info("Waiting for the bridge lease, on standby until then")
+
+	if err := bridgeLease.Acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	return bridgeLease, nil
+}
+
+// leaseLost returns a channel closed once the lease is lost, it is never
+// closed without lease
+func leaseLost(bridgeLease lease.Lease) <-chan struct{} {
+	if bridgeLease == nil {
+		return nil
+	}
+
+	return bridgeLease.Lost()
+}
+
+// leaseErr returns errLeaseLost if the bridge stopped because it lost the lease
+func leaseErr(bridgeLease lease.Lease) error {
+	select {
+	case <-leaseLost(bridgeLease):
+		return errLeaseLost
+	default:
+		return nil
+	}
+}
+
+// releaseLease lets a standby instance take over
+func releaseLease(bridgeLease lease.Lease) {
+	if bridgeLease == nil {
+		return
+	}
+
+	if err := bridgeLease.Release(); err != nil {
+		logger.Error("releaseLease | Release", "Error", err)
+	}
+}
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/streadway/amqp"
 )
 
@@ -18,6 +20,26 @@ var purgeCmd = &cobra.Command{
 }
 
 func purgeQueue() {
+	if helper.GetConfig().QueueBackend == helper.LevelDBQueueBackend {
+		db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+		if db == nil {
+			logger.Error("purgeQueue | bridge db is not available, make sure the bridge is stopped")
+			return
+		}
+
+		defer util.CloseBridgeDBInstance()
+
+		count, err := queue.PurgeLevelDBTasks(db)
+		if err != nil {
+			logger.Error("purgeQueue | PurgeLevelDBTasks", "Error", err)
+			return
+		}
+
+		logger.Info("Purged bridge db tasks", "count", count)
+
+		return
+	}
+
 	dialer := helper.GetConfig().AmqpURL
 
 	// amqp dialer
@@ -33,15 +33,51 @@ const (
 // StartBridgeWithCtx starts bridge service and is able to shutdow gracefully
 // returns service errors, if any
 func StartBridgeWithCtx(shutdownCtx context.Context) error {
+	// the bridge signs its heimdall and main chain txs with the validator key
+	if err := helper.LoadValidatorKey(); err != nil {
+		logger.Error("Error loading validator key", "error", err)
+
+		return err
+	}
+
+	// wait for the lease before opening the bridge db, a standby instance
+	// resumes from the blocks persisted by the active one
+	bridgeLease, err := acquireLease(shutdownCtx)
+	if err != nil {
+		if shutdownCtx.Err() != nil {
+			return nil
+		}
+
+		logger.Error("Error acquiring bridge lease", "error", err)
+
+		return err
+	}
+
+	// stop the bridge if another instance takes the lease over
+	shutdownCtx, cancel := context.WithCancel(shutdownCtx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-leaseLost(bridgeLease):
+			logger.Error("Lost the bridge lease, stopping bridge services")
+			cancel()
+		case <-shutdownCtx.Done():
+		}
+	}()
+
 	// create codec
 	cdc := app.MakeCodec()
 	// queue connector & http client
-	_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+	_queueConnector := newQueueConnector()
 	_queueConnector.StartWorker()
 
 	_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
 	_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
+	// serve the bridge health next to the metrics
+	registerHealthHandlers(_queueConnector, _txBroadcaster)
+
 	// selected services to start
 	services := []common.Service{}
 	services = append(services,
@@ -50,7 +86,7 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 	)
 
 	// Start http client
-	err := _httpClient.Start()
+	err = _httpClient.Start()
 	if err != nil {
 		logger.Error("Error connecting to server: %v", err)
 		return err
@@ -66,7 +102,8 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 	for loop {
 		select {
 		case <-shutdownCtx.Done():
-			return nil
+			releaseLease(bridgeLease)
+			return leaseErr(bridgeLease)
 		case <-time.After(waitDuration):
 			if !util.IsCatchingUp(cliCtx) {
 				logger.Info("Node up to date, starting bridge services")
@@ -115,9 +152,15 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 			logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
 			return err
 		}
+		// stop queue worker before closing the db it may use
+		_queueConnector.StopWorker()
+
 		// stop db instance
 		util.CloseBridgeDBInstance()
 
+		// let a standby instance take over
+		releaseLease(bridgeLease)
+
 		return nil
 	})
 
@@ -127,20 +170,35 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 		return err
 	}
 
-	return nil
+	return leaseErr(bridgeLease)
 }
 
 // StartBridge starts bridge service, isStandAlone prevents os.Exit if the bridge started as side service
 func StartBridge(isStandAlone bool) {
+	// the bridge signs its heimdall and main chain txs with the validator key
+	if err := helper.LoadValidatorKey(); err != nil {
+		panic(fmt.Sprintf("Error loading validator key %v", err))
+	}
+
+	// wait for the lease before opening the bridge db, a standby instance
+	// resumes from the blocks persisted by the active one
+	bridgeLease, err := acquireLease(context.Background())
+	if err != nil {
+		panic(fmt.Sprintf("Error acquiring bridge lease %v", err))
+	}
+
 	// create codec
 	cdc := app.MakeCodec()
 	// queue connector & http client
-	_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+	_queueConnector := newQueueConnector()
 	_queueConnector.StartWorker()
 
 	_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
 	_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
+	// serve the bridge health next to the metrics
+	registerHealthHandlers(_queueConnector, _txBroadcaster)
+
 	// selected services to start
 	services := []common.Service{}
 	services = append(services,
@@ -172,9 +230,15 @@ func StartBridge(isStandAlone bool) {
 				logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
 			}
 
+			// stop queue worker before closing the db it may use
+			_queueConnector.StopWorker()
+
 			// stop db instance
 			util.CloseBridgeDBInstance()
 
+			// let a standby instance take over
+			releaseLease(bridgeLease)
+
 			// exit
 			if isStandAlone {
 				os.Exit(1)
@@ -182,8 +246,18 @@ func StartBridge(isStandAlone bool) {
 		}
 	}()
 
+	// stop the bridge if another instance takes the lease over
+	if bridgeLease != nil {
+		go func() {
+			<-bridgeLease.Lost()
+
+			logger.Error("Lost the bridge lease, stopping bridge services")
+			catchSignal <- syscall.SIGTERM
+		}()
+	}
+
 	// Start http client
-	err := _httpClient.Start()
+	err = _httpClient.Start()
 	if err != nil {
 		panic(fmt.Sprintf("Error connecting to server %v", err))
 	}
@@ -223,6 +297,20 @@ func StartBridge(isStandAlone bool) {
 	wg.Wait()
 }
 
+// newQueueConnector returns the queue connector for the configured backend
+func newQueueConnector() *queue.QueueConnector {
+	db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+
+	switch backend := helper.GetConfig().QueueBackend; backend {
+	case helper.LevelDBQueueBackend:
+		return queue.NewLevelDBQueueConnector(db)
+	case helper.AmqpQueueBackend, "":
+		return queue.NewQueueConnector(helper.GetConfig().AmqpURL, db)
+	default:
+		panic(fmt.Sprintf("unknown queue backend %v", backend))
+	}
+}
+
 // GetStartCmd returns the start command to start bridge
 func GetStartCmd() *cobra.Command {
 	startCmd := &cobra.Command{
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// tasksCmd groups the commands for tasks which ran out of retries
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Inspect and replay bridge tasks which ran out of retries (the bridge must be stopped)",
+}
+
+var tasksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List failed tasks",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		defer util.CloseBridgeDBInstance()
+
+		deadLetters, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "UUID\tTASK\tATTEMPTS\tFAILED AT\tREASON")
+
+		for _, deadLetter := range deadLetters {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+				deadLetter.UUID,
+				deadLetter.TaskName,
+				deadLetter.Attempts,
+				deadLetter.FailedAt.Format(time.RFC3339),
+				deadLetter.Reason,
+			)
+		}
+
+		return w.Flush()
+	},
+}
+
+var tasksShowCmd = &cobra.Command{
+	Use:   "show [uuid]",
+	Short: "Show a failed task with its event payload",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		defer util.CloseBridgeDBInstance()
+
+		deadLetter, err := store.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		out, err := json.MarshalIndent(deadLetter, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(out))
+
+		return nil
+	},
+}
+
+var tasksReplayCmd = &cobra.Command{
+	Use:   "replay [uuid]",
+	Short: "Queue a failed task again, it runs once the bridge is started",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		defer util.CloseBridgeDBInstance()
+
+		if err := store.Replay(args[0], newQueueConnector()); err != nil {
+			return err
+		}
+
+		logger.Info("Replayed task", "uuid", args[0])
+
+		return nil
+	},
+}
+
+var tasksDropCmd = &cobra.Command{
+	Use:   "drop [uuid]",
+	Short: "Remove a failed task",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		defer util.CloseBridgeDBInstance()
+
+		if err := store.Delete(args[0]); err != nil {
+			return err
+		}
+
+		logger.Info("Dropped task", "uuid", args[0])
+
+		return nil
+	},
+}
+
+// openDeadLetterStore opens the bridge db, which is locked while the bridge runs
+func openDeadLetterStore() (*queue.DeadLetterStore, error) {
+	db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+	if db == nil {
+		return nil, errors.New("bridge db is not available, make sure the bridge is stopped")
+	}
+
+	return queue.NewDeadLetterStore(db), nil
+}
+
+func init() {
+	tasksCmd.AddCommand(
+		tasksListCmd,
+		tasksShowCmd,
+		tasksReplayCmd,
+		tasksDropCmd,
+	)
+
+	rootCmd.AddCommand(tasksCmd)
+}
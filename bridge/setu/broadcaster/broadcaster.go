@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bor "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
@@ -31,8 +33,33 @@ type TxBroadcaster struct {
 	heimdallMutex sync.Mutex
 	maticMutex    sync.Mutex
 
-	lastSeqNo uint64
+	sequences *sequenceManager
 	accNum    uint64
+
+	// pending batches of heimdall msgs, by msg route and type
+	batchMutex sync.Mutex
+	batches    map[string]*heimdallBatch
+
+	// auth params bounding the batches, cached for authParamsTTL
+	authParamsMutex  sync.Mutex
+	authParams       *authTypes.Params
+	authParamsExpiry time.Time
+}
+
+const (
+	// authParamsTTL is how long the auth params are cached
+	authParamsTTL = time.Minute
+)
+
+// heimdallBatch is a set of compatible msgs broadcast in a single heimdall tx
+type heimdallBatch struct {
+	msgs  []sdk.Msg
+	bytes uint64
+	limit int
+
+	once sync.Once
+	done chan struct{}
+	err  error
 }
 
 // NewTxBroadcaster creates new broadcaster
@@ -49,60 +76,256 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 		panic("Error connecting to rest-server, please start server before bridge.")
 	}
 
+	logger := util.Logger().With("module", "txBroadcaster")
+
+	sequences := newSequenceManager(
+		logger,
+		util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		&heimdallSequenceClient{cliCtx: cliCtx, address: address},
+	)
+
+	// pick up the txs still in flight before a restart
+	if err := sequences.Reconcile(); err != nil {
+		panic(fmt.Sprintf("Error reconciling the heimdall account sequence: %v", err))
+	}
+
 	return &TxBroadcaster{
-		logger:    util.Logger().With("module", "txBroadcaster"),
+		logger:    logger,
 		CliCtx:    cliCtx,
-		lastSeqNo: account.GetSequence(),
+		sequences: sequences,
 		accNum:    account.GetAccountNumber(),
+		batches:   make(map[string]*heimdallBatch),
 	}
 }
 
-// BroadcastToHeimdall broadcast to heimdall
+// BroadcastToHeimdall broadcast to heimdall. Compatible msgs are batched
+// into a single tx when batching is enabled, it returns once the batch of
+// the msg is broadcast.
 func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg, event interface{}) error {
+	defer util.LogElapsedTimeForStateSyncedEvent(event, "BroadcastToHeimdall", time.Now())
+
+	limit := tb.batchLimit(msg)
+	if limit <= 1 {
+		_, err := tb.broadcastMsgsToHeimdall([]sdk.Msg{msg})
+		return err
+	}
+
+	size, err := tb.msgSize(msg)
+	if err != nil {
+		tb.logger.Error("Error encoding msg, broadcasting it on its own", "error", err)
+		_, err = tb.broadcastMsgsToHeimdall([]sdk.Msg{msg})
+
+		return err
+	}
+
+	batch := tb.addToBatch(msg, size, limit)
+	<-batch.done
+
+	return batch.err
+}
+
+// addToBatch adds the msg to the pending batch of its type, or starts a new
+// batch if there is none or the msg doesn't fit in it
+func (tb *TxBroadcaster) addToBatch(msg sdk.Msg, size uint64, limit int) *heimdallBatch {
+	tb.batchMutex.Lock()
+	defer tb.batchMutex.Unlock()
+
+	key := batchKey(msg)
+
+	batch, ok := tb.batches[key]
+	if ok && batch.bytes+size > helper.GetConfig().HeimdallTxBatchMaxBytes {
+		// the msg doesn't fit, broadcast the batch now
+		delete(tb.batches, key)
+
+		go tb.flushBatch(key, batch)
+
+		ok = false
+	}
+
+	if !ok {
+		batch = &heimdallBatch{
+			limit: limit,
+			done:  make(chan struct{}),
+		}
+		tb.batches[key] = batch
+
+		time.AfterFunc(helper.GetConfig().HeimdallTxBatchWait, func() {
+			tb.flushBatch(key, batch)
+		})
+	}
+
+	batch.msgs = append(batch.msgs, msg)
+	batch.bytes += size
+
+	if len(batch.msgs) >= batch.limit {
+		delete(tb.batches, key)
+
+		go tb.flushBatch(key, batch)
+	}
+
+	return batch
+}
+
+// flushBatch broadcasts the msgs of the batch, only once
+func (tb *TxBroadcaster) flushBatch(key string, batch *heimdallBatch) {
+	batch.once.Do(func() {
+		tb.batchMutex.Lock()
+		if tb.batches[key] == batch {
+			delete(tb.batches, key)
+		}
+		tb.batchMutex.Unlock()
+
+		tb.logger.Debug("Broadcasting heimdall batch tx", "type", key, "msgs", len(batch.msgs), "bytes", batch.bytes)
+
+		_, err := tb.broadcastMsgsToHeimdall(batch.msgs)
+
+		batch.err = err
+		close(batch.done)
+	})
+}
+
+// batchLimit returns the max number of msgs in a batch tx with the msg,
+// bounded by the config, the auth params and the max gas of a batch
+func (tb *TxBroadcaster) batchLimit(msg sdk.Msg) int {
+	limit := helper.GetConfig().HeimdallTxBatchSize
+	if limit <= 1 || !isBatchable(msg) {
+		return 1
+	}
+
+	if util.GetBlockHeight(tb.CliCtx) < helper.GetBatchTxHeight() {
+		return 1
+	}
+
+	params, err := tb.getAuthParams()
+	if err != nil {
+		tb.logger.Error("Error fetching auth params, broadcasting msg on its own", "error", err)
+		return 1
+	}
+
+	if maxTxMsgs := params.GetMaxTxMsgs(); maxTxMsgs < limit {
+		limit = maxTxMsgs
+	}
+
+	// each msg of a batch uses the max tx gas
+	if params.MaxTxGas > 0 {
+		if maxGasMsgs := helper.GetConfig().HeimdallTxBatchMaxGas / params.MaxTxGas; maxGasMsgs < limit {
+			limit = maxGasMsgs
+		}
+	}
+
+	return int(limit)
+}
+
+// getAuthParams returns the auth params, fetched from heimdall at most once
+// every authParamsTTL
+func (tb *TxBroadcaster) getAuthParams() (*authTypes.Params, error) {
+	tb.authParamsMutex.Lock()
+	defer tb.authParamsMutex.Unlock()
+
+	if tb.authParams != nil && time.Now().Before(tb.authParamsExpiry) {
+		return tb.authParams, nil
+	}
+
+	params, err := util.GetAuthParams(tb.CliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	tb.authParams = params
+	tb.authParamsExpiry = time.Now().Add(authParamsTTL)
+
+	return params, nil
+}
+
+// msgSize returns the encoded size of the msg
+func (tb *TxBroadcaster) msgSize(msg sdk.Msg) (uint64, error) {
+	bz, err := tb.CliCtx.Codec.MarshalBinaryBare(msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(len(bz)), nil
+}
+
+// broadcastMsgsToHeimdall broadcasts the msgs in a single tx and returns its
+// hash. The tx is kept as in flight until it is committed, a failed
+// broadcast reconciles the sequence with heimdall.
+func (tb *TxBroadcaster) broadcastMsgsToHeimdall(msgs []sdk.Msg) (string, error) {
 	tb.heimdallMutex.Lock()
 	defer tb.heimdallMutex.Unlock()
-	defer util.LogElapsedTimeForStateSyncedEvent(event, "BroadcastToHeimdall", time.Now())
 
 	// tx encoder
 	txEncoder := helper.GetTxEncoder(tb.CliCtx.Codec)
 	// chain id
 	chainID := helper.GetGenesisDoc().ChainID
 
+	sequence, err := tb.sequences.Next()
+	if err != nil {
+		tb.logger.Error("Error getting the heimdall account sequence", "error", err)
+		return "", err
+	}
+
 	// get account number and sequence
 	txBldr := authTypes.NewTxBuilderFromCLI().
 		WithTxEncoder(txEncoder).
 		WithAccountNumber(tb.accNum).
-		WithSequence(tb.lastSeqNo).
+		WithSequence(sequence).
 		WithChainID(chainID)
 
-	txResponse, err := helper.BuildAndBroadcastMsgs(tb.CliCtx, txBldr, []sdk.Msg{msg})
+	txBytes, err := helper.GetSignedTxBytes(tb.CliCtx, txBldr, msgs)
 	if err != nil {
-		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
+		tb.logger.Error("Error while signing the heimdall transaction", "error", err)
+		return "", err
+	}
 
-		// current address
-		address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+	if err := tb.sequences.Track(sequence, txBytes); err != nil {
+		tb.logger.Error("Error while storing the in-flight heimdall transaction", "error", err)
+		return "", err
+	}
 
-		// fetch from APIs
-		account, errAcc := util.GetAccount(tb.CliCtx, address)
-		if errAcc != nil {
-			tb.logger.Error("Error fetching account from rest-api", "url", helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
-			return errAcc
+	txResponse, err := helper.BroadcastTxBytes(tb.CliCtx, txBytes, "")
+	if err == nil && txResponse.Code != abci.CodeTypeOK {
+		err = fmt.Errorf("tx rejected by heimdall, code %d: %s", txResponse.Code, txResponse.RawLog)
+	}
+
+	if err != nil {
+		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
+
+		if errDrop := tb.sequences.Drop(sequence); errDrop != nil {
+			tb.logger.Error("Error while dropping the in-flight heimdall transaction", "error", errDrop)
 		}
 
-		// update seqNo for safety
-		tb.lastSeqNo = account.GetSequence()
+		// the tx may still have reached the mempool, or the sequence is out of sync
+		if errReconcile := tb.sequences.Reconcile(); errReconcile != nil {
+			tb.logger.Error("Error reconciling the heimdall account sequence", "url", helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())), "error", errReconcile)
+		}
 
-		return err
+		return "", err
 	}
 
 	txHash := txResponse.TxHash
 
-	tb.logger.Info("Tx sent on heimdall", "txHash", txHash, "accSeq", tb.lastSeqNo, "accNum", tb.accNum)
+	tb.logger.Info("Tx sent on heimdall", "txHash", txHash, "msgs", len(msgs), "accSeq", sequence, "accNum", tb.accNum)
 	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
-	// increment account sequence
-	tb.lastSeqNo += 1
 
-	return nil
+	return txHash, nil
+}
+
+// SequenceDrift returns the sequence the next heimdall tx is signed with,
+// along with the account sequence known to heimdall. They differ while txs
+// are pending in the mempool, or once the local sequence went out of sync.
+func (tb *TxBroadcaster) SequenceDrift() (uint64, uint64, error) {
+	localSeqNo := tb.sequences.Current()
+
+	// current address
+	address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+
+	account, err := util.GetAccount(tb.CliCtx, address)
+	if err != nil {
+		return localSeqNo, 0, err
+	}
+
+	return localSeqNo, account.GetSequence(), nil
 }
 
 // BroadcastToMatic broadcast to matic
@@ -122,7 +345,20 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	}
 
 	// Create the transaction, sign it and schedule it for execution
-	rawTx := types.NewTransaction(auth.Nonce.Uint64(), *msg.To, msg.Value, auth.GasLimit, auth.GasPrice, msg.Data)
+	var rawTx *types.Transaction
+	if auth.GasPrice == nil {
+		rawTx = types.NewTx(&types.DynamicFeeTx{
+			Nonce:     auth.Nonce.Uint64(),
+			To:        msg.To,
+			Value:     msg.Value,
+			Gas:       auth.GasLimit,
+			GasTipCap: auth.GasTipCap,
+			GasFeeCap: auth.GasFeeCap,
+			Data:      msg.Data,
+		})
+	} else {
+		rawTx = types.NewTransaction(auth.Nonce.Uint64(), *msg.To, msg.Value, auth.GasLimit, auth.GasPrice, msg.Data)
+	}
 
 	// signer
 	signedTx, err := auth.Signer(auth.From, rawTx)
@@ -148,3 +384,15 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 
 // BroadcastToRootchain broadcast to rootchain
 func (tb *TxBroadcaster) BroadcastToRootchain() {}
+
+// isBatchable checks if the msg can share a tx with other msgs. Validators
+// vote on side txs as a whole, so side-tx msgs always get a tx of their own.
+func isBatchable(msg sdk.Msg) bool {
+	_, ok := msg.(hmTypes.SideTxMsg)
+	return !ok
+}
+
+// batchKey returns the key of the batches the msg is compatible with
+func batchKey(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/app"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/spf13/viper"
@@ -59,3 +60,19 @@ func TestBroadcastToHeimdall(t *testing.T) {
 		})
 	}
 }
+
+func TestIsBatchable(t *testing.T) {
+	t.Parallel()
+
+	proposer := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+
+	checkpointMsg := checkpointTypes.NewMsgCheckpointBlock(proposer, 0, 63, hmTypes.ZeroHeimdallHash, hmTypes.ZeroHeimdallHash, "15001")
+	assert.False(t, isBatchable(checkpointMsg), "Checkpoint msgs should never be batched")
+
+	recordMsg := clerkTypes.NewMsgEventRecord(proposer, hmTypes.ZeroHeimdallHash, 1, 1, 1, proposer, nil, "15001")
+	assert.False(t, isBatchable(recordMsg), "Side-tx msgs should never be batched")
+
+	noAckMsg := checkpointTypes.NewMsgCheckpointNoAck(proposer)
+	assert.True(t, isBatchable(noAckMsg), "Msgs without side-tx handler should be batched")
+	assert.Equal(t, "checkpoint/checkpoint-no-ack", batchKey(noAckMsg))
+}
@@ -0,0 +1,310 @@
+package broadcaster
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/syndtr/goleveldb/leveldb"
+	dbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// storage key prefix of the heimdall txs waiting to be committed, followed by their sequence
+	inFlightTxKeyPrefix = "heimdall-tx-inflight-"
+
+	// in-flight txs older than this are checked against the chain before the next broadcast
+	reconcileInterval = time.Minute
+)
+
+// inFlightTx is a heimdall tx broadcast by the bridge and not committed yet
+type inFlightTx struct {
+	Sequence uint64    `json:"sequence"`
+	TxBytes  []byte    `json:"txBytes"`
+	SentAt   time.Time `json:"sentAt"`
+}
+
+func (tx *inFlightTx) hash() string {
+	return strings.ToUpper(hex.EncodeToString(tmTypes.Tx(tx.TxBytes).Hash()))
+}
+
+// sequenceClient is the part of heimdall used by the sequence manager
+type sequenceClient interface {
+	// AccountSequence returns the sequence of the committed txs of the account
+	AccountSequence() (uint64, error)
+	// PendingTxs returns the txs of the account in the mempool, and whether
+	// the whole mempool was listed
+	PendingTxs() ([][]byte, bool, error)
+	// BroadcastTx sends the tx to the mempool again
+	BroadcastTx(txBytes []byte) error
+}
+
+// sequenceManager hands out the sequences of the heimdall txs. The txs are
+// kept in the bridge db until they are committed, so the sequence survives
+// restarts and txs sent by another bridge instance with the same account are
+// taken into account through the mempool.
+type sequenceManager struct {
+	logger log.Logger
+	db     *leveldb.DB
+	client sequenceClient
+
+	mu         sync.Mutex
+	next       uint64
+	reconciled time.Time
+}
+
+func newSequenceManager(logger log.Logger, db *leveldb.DB, client sequenceClient) *sequenceManager {
+	return &sequenceManager{
+		logger: logger,
+		db:     db,
+		client: client,
+	}
+}
+
+// Next returns the sequence of the next tx, reconciled with heimdall if some
+// in-flight txs are pending for too long
+func (sm *sequenceManager) Next() (uint64, error) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if time.Since(sm.reconciled) >= reconcileInterval {
+		txs, err := sm.inFlightTxs()
+		if err != nil {
+			return 0, err
+		}
+
+		if len(txs) > 0 && time.Since(txs[0].SentAt) >= reconcileInterval {
+			if err := sm.reconcile(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return sm.next, nil
+}
+
+// Track stores the tx sent with the next sequence and moves to the following one
+func (sm *sequenceManager) Track(sequence uint64, txBytes []byte) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if err := sm.putInFlightTx(&inFlightTx{
+		Sequence: sequence,
+		TxBytes:  txBytes,
+		SentAt:   time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	if sequence >= sm.next {
+		sm.next = sequence + 1
+	}
+
+	return nil
+}
+
+// Current returns the sequence of the next tx, as last known
+func (sm *sequenceManager) Current() uint64 {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	return sm.next
+}
+
+// Drop forgets the tx sent with the sequence, when heimdall rejected it
+func (sm *sequenceManager) Drop(sequence uint64) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	return sm.db.Delete(inFlightTxKey(sequence), nil)
+}
+
+// Reconcile checks the in-flight txs against heimdall
+func (sm *sequenceManager) Reconcile() error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	return sm.reconcile()
+}
+
+// reconcile forgets the committed txs and sends the in-flight txs missing in
+// the mempool again, in sequence order. The txs which can't be sent again
+// are dropped along with the following ones, as their sequence is taken.
+// The next sequence follows the txs of the account in the mempool. When the
+// mempool is too large to be listed, the in-flight txs missing in the list
+// are sent again as well, the mempool rejects the ones it already has.
+func (sm *sequenceManager) reconcile() error {
+	accountSeq, err := sm.client.AccountSequence()
+	if err != nil {
+		return err
+	}
+
+	pendingTxs, complete, err := sm.client.PendingTxs()
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[string]bool, len(pendingTxs))
+	for _, txBytes := range pendingTxs {
+		pending[string(txBytes)] = true
+	}
+
+	txs, err := sm.inFlightTxs()
+	if err != nil {
+		return err
+	}
+
+	next := accountSeq + uint64(len(pendingTxs))
+	dropping := false
+
+	for _, tx := range txs {
+		switch {
+		case tx.Sequence < accountSeq:
+			sm.logger.Debug("In-flight heimdall tx committed", "txHash", tx.hash(), "sequence", tx.Sequence)
+		case pending[string(tx.TxBytes)]:
+			continue
+		case !dropping && (tx.Sequence == next || (!complete && tx.Sequence > next)):
+			if err := sm.client.BroadcastTx(tx.TxBytes); err != nil {
+				sm.logger.Error("Error sending in-flight heimdall tx again, dropping it", "txHash", tx.hash(), "sequence", tx.Sequence, "error", err)
+				dropping = true
+
+				break
+			}
+
+			sm.logger.Info("Sent in-flight heimdall tx missing in mempool again", "txHash", tx.hash(), "sequence", tx.Sequence)
+
+			tx.SentAt = time.Now()
+			if err := sm.putInFlightTx(tx); err != nil {
+				return err
+			}
+
+			next = tx.Sequence + 1
+
+			continue
+		default:
+			sm.logger.Info("Dropping in-flight heimdall tx, its sequence is taken", "txHash", tx.hash(), "sequence", tx.Sequence, "next", next)
+			dropping = true
+		}
+
+		if err := sm.db.Delete(inFlightTxKey(tx.Sequence), nil); err != nil {
+			return err
+		}
+	}
+
+	if next != sm.next {
+		sm.logger.Info("Reconciled heimdall account sequence", "accountSeq", accountSeq, "pending", len(pendingTxs), "previous", sm.next, "next", next)
+	}
+
+	sm.next = next
+	sm.reconciled = time.Now()
+
+	return nil
+}
+
+// inFlightTxs returns the in-flight txs in sequence order
+func (sm *sequenceManager) inFlightTxs() ([]*inFlightTx, error) {
+	iter := sm.db.NewIterator(dbUtil.BytesPrefix([]byte(inFlightTxKeyPrefix)), nil)
+	defer iter.Release()
+
+	var txs []*inFlightTx
+
+	for iter.Next() {
+		var tx inFlightTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, &tx)
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Sequence < txs[j].Sequence
+	})
+
+	return txs, iter.Error()
+}
+
+func (sm *sequenceManager) putInFlightTx(tx *inFlightTx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	return sm.db.Put(inFlightTxKey(tx.Sequence), value, nil)
+}
+
+func inFlightTxKey(sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", inFlightTxKeyPrefix, sequence))
+}
+
+// heimdallSequenceClient reads the account and mempool from heimdall
+type heimdallSequenceClient struct {
+	cliCtx  cliContext.CLIContext
+	address hmTypes.HeimdallAddress
+}
+
+func (c *heimdallSequenceClient) AccountSequence() (uint64, error) {
+	account, err := util.GetAccount(c.cliCtx, c.address)
+	if err != nil {
+		return 0, err
+	}
+
+	return account.GetSequence(), nil
+}
+
+func (c *heimdallSequenceClient) PendingTxs() ([][]byte, bool, error) {
+	txs, total, err := util.GetUnconfirmedTxs()
+	if err != nil {
+		return nil, false, err
+	}
+
+	decoder := helper.GetTxDecoder(c.cliCtx.Codec)
+
+	var pending [][]byte
+
+	for _, txBytes := range txs {
+		tx, err := decoder(txBytes)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			if signers := msg.GetSigners(); len(signers) > 0 && bytes.Equal(signers[0].Bytes(), c.address.Bytes()) {
+				pending = append(pending, txBytes)
+				break
+			}
+		}
+	}
+
+	return pending, total <= len(txs), nil
+}
+
+func (c *heimdallSequenceClient) BroadcastTx(txBytes []byte) error {
+	txResponse, err := helper.BroadcastTxBytes(c.cliCtx, txBytes, "")
+	if err != nil {
+		// the tx is in the mempool, beyond the txs listed by heimdall
+		if strings.Contains(err.Error(), "tx already exists in cache") {
+			return nil
+		}
+
+		return err
+	}
+
+	if txResponse.Code != 0 {
+		return fmt.Errorf("tx rejected by heimdall, code %d: %s", txResponse.Code, txResponse.RawLog)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package broadcaster
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeSequenceClient is a heimdall account with txs in the mempool
+type fakeSequenceClient struct {
+	accountSeq uint64
+	mempool    [][]byte
+	unlisted   int
+	rejected   map[string]bool
+	sent       [][]byte
+}
+
+func (c *fakeSequenceClient) AccountSequence() (uint64, error) {
+	return c.accountSeq, nil
+}
+
+func (c *fakeSequenceClient) PendingTxs() ([][]byte, bool, error) {
+	return c.mempool[:len(c.mempool)-c.unlisted], c.unlisted == 0, nil
+}
+
+func (c *fakeSequenceClient) BroadcastTx(txBytes []byte) error {
+	if c.rejected[string(txBytes)] {
+		return errors.New("invalid sequence")
+	}
+
+	// the tx is already in the mempool cache
+	for _, tx := range c.mempool {
+		if bytes.Equal(tx, txBytes) {
+			return nil
+		}
+	}
+
+	c.sent = append(c.sent, txBytes)
+	c.mempool = append(c.mempool, txBytes)
+
+	return nil
+}
+
+func newTestSequenceManager(t *testing.T, client *fakeSequenceClient) *sequenceManager {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	return newSequenceManager(log.NewNopLogger(), db, client)
+}
+
+func TestSequenceManagerNext(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeSequenceClient{accountSeq: 5}
+	sm := newTestSequenceManager(t, client)
+
+	require.NoError(t, sm.Reconcile())
+
+	seq, err := sm.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), seq, "Sequence should start at the account sequence")
+
+	require.NoError(t, sm.Track(seq, []byte("tx-5")))
+
+	seq, err = sm.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), seq, "Sequence should follow the tracked tx")
+
+	// another bridge instance sent two txs with the same account
+	client.mempool = [][]byte{[]byte("tx-5"), []byte("other-6"), []byte("other-7")}
+	require.NoError(t, sm.Reconcile())
+	require.Equal(t, uint64(8), sm.Current(), "Sequence should follow the txs of the account in the mempool")
+}
+
+func TestSequenceManagerReconcile(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeSequenceClient{accountSeq: 10}
+	sm := newTestSequenceManager(t, client)
+
+	for seq, tx := range map[uint64]string{9: "tx-9", 10: "tx-10", 11: "tx-11", 12: "tx-12", 13: "tx-13"} {
+		require.NoError(t, sm.Track(seq, []byte(tx)))
+	}
+
+	// tx-10 is in the mempool, tx-11 got lost and tx-12 was rejected
+	client.mempool = [][]byte{[]byte("tx-10")}
+	client.rejected = map[string]bool{"tx-12": true}
+
+	require.NoError(t, sm.Reconcile())
+
+	require.Equal(t, [][]byte{[]byte("tx-11")}, client.sent, "Lost tx should be sent again")
+	require.Equal(t, uint64(12), sm.Current(), "Sequence should follow the txs in the mempool")
+
+	txs, err := sm.inFlightTxs()
+	require.NoError(t, err)
+
+	var sequences []uint64
+	for _, tx := range txs {
+		sequences = append(sequences, tx.Sequence)
+	}
+
+	require.Equal(t, []uint64{10, 11}, sequences, "Committed and dropped txs should be forgotten")
+
+	// tx-10 and tx-11 are committed
+	client.accountSeq, client.mempool = 12, nil
+
+	require.NoError(t, sm.Reconcile())
+	require.Equal(t, uint64(12), sm.Current())
+
+	txs, err = sm.inFlightTxs()
+	require.NoError(t, err)
+	require.Empty(t, txs, "Committed txs should be forgotten")
+}
+
+func TestSequenceManagerReconcileUnlistedMempool(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeSequenceClient{accountSeq: 10}
+	sm := newTestSequenceManager(t, client)
+
+	for seq, tx := range map[uint64]string{10: "tx-10", 12: "tx-12"} {
+		require.NoError(t, sm.Track(seq, []byte(tx)))
+	}
+
+	// the mempool is too large to be listed, other-11 and tx-12 are beyond the listed txs
+	client.mempool = [][]byte{[]byte("tx-10"), []byte("other-11"), []byte("tx-12")}
+	client.unlisted = 2
+
+	require.NoError(t, sm.Reconcile())
+	require.Empty(t, client.sent, "Txs already in the mempool should not be added again")
+	require.Equal(t, uint64(13), sm.Current(), "Sequence should follow the unlisted txs of the account")
+
+	txs, err := sm.inFlightTxs()
+	require.NoError(t, err)
+	require.Len(t, txs, 2, "Unlisted txs in the mempool should be kept")
+}
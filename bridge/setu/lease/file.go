@@ -0,0 +1,253 @@
+package lease
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fileRecord is the content of the lease file
+type fileRecord struct {
+	Owner     string    `json:"owner"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// FileLease is a lease kept in a file shared by the bridge instances. The
+// holder renews it before it expires, the other instances take it over
+// once it expired. The lease file is only read and written under an
+// exclusive lock, so a single instance takes over an expired lease.
+type FileLease struct {
+	logger log.Logger
+	path   string
+	owner  string
+	ttl    time.Duration
+	lock   *flock.Flock
+
+	lost     chan struct{}
+	lostOnce sync.Once
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewFileLease returns a file lease held as owner
+func NewFileLease(logger log.Logger, path string, owner string, ttl time.Duration) *FileLease {
+	return &FileLease{
+		logger: logger,
+		path:   path,
+		owner:  owner,
+		ttl:    ttl,
+		lock:   flock.New(path + ".lock"),
+		lost:   make(chan struct{}),
+	}
+}
+
+// Acquire blocks until the lease is held or the context is done
+func (l *FileLease) Acquire(ctx context.Context) error {
+	for {
+		acquired, err := l.tryAcquire()
+		if err != nil {
+			l.logger.Error("Error acquiring bridge lease", "path", l.path, "error", err)
+		}
+
+		if acquired {
+			l.logger.Info("Acquired bridge lease", "path", l.path, "owner", l.owner)
+
+			renewCtx, cancel := context.WithCancel(context.Background())
+			l.cancel = cancel
+			l.done = make(chan struct{})
+
+			go l.renew(renewCtx)
+
+			return nil
+		}
+
+		if !waitRetry(ctx, l.logger, l.ttl) {
+			return ctx.Err()
+		}
+	}
+}
+
+// Lost is closed once the lease is no longer held
+func (l *FileLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Release stops renewing the lease and removes it, if still held
+func (l *FileLease) Release() error {
+	if l.cancel == nil {
+		return nil
+	}
+
+	l.cancel()
+	<-l.done
+
+	return l.withLock(func() error {
+		record, err := l.read()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if record.Owner != l.owner {
+			return nil
+		}
+
+		return os.Remove(l.path)
+	})
+}
+
+func (l *FileLease) tryAcquire() (acquired bool, err error) {
+	err = l.withLock(func() error {
+		record, err := l.read()
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		if record != nil && record.Owner != l.owner && time.Now().Before(record.ExpiresAt) {
+			return nil
+		}
+
+		if err := l.write(); err != nil {
+			return err
+		}
+
+		acquired = true
+
+		return nil
+	})
+
+	return acquired, err
+}
+
+// renew extends the lease until the context is done or it is lost
+func (l *FileLease) renew(ctx context.Context) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(retryInterval(l.ttl))
+	defer ticker.Stop()
+
+	renewed := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var owner string
+
+			err := l.withLock(func() error {
+				record, err := l.read()
+				if err != nil {
+					return err
+				}
+
+				if owner = record.Owner; owner != l.owner {
+					return nil
+				}
+
+				return l.write()
+			})
+
+			if err == nil && owner != l.owner {
+				l.logger.Error("Bridge lease taken over by another instance", "path", l.path, "owner", owner)
+				l.setLost()
+
+				return
+			}
+
+			if err != nil {
+				l.logger.Error("Error renewing bridge lease", "path", l.path, "error", err)
+
+				if time.Since(renewed) >= l.ttl {
+					l.logger.Error("Bridge lease expired", "path", l.path)
+					l.setLost()
+
+					return
+				}
+
+				continue
+			}
+
+			renewed = time.Now()
+		}
+	}
+}
+
+func (l *FileLease) setLost() {
+	l.lostOnce.Do(func() {
+		close(l.lost)
+	})
+}
+
+// withLock runs fn while holding the lock of the lease file, it waits for
+// the other instances to release it
+func (l *FileLease) withLock(fn func() error) error {
+	if err := l.lock.Lock(); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := l.lock.Unlock(); err != nil {
+			l.logger.Error("Error unlocking bridge lease file", "path", l.path, "error", err)
+		}
+	}()
+
+	return fn()
+}
+
+func (l *FileLease) read() (*fileRecord, error) {
+	value, err := os.ReadFile(l.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var record fileRecord
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// write stores the lease as ours, replacing the file at once so it is never
+// read half written
+func (l *FileLease) write() error {
+	value, err := json.Marshal(fileRecord{
+		Owner:     l.owner,
+		ExpiresAt: time.Now().Add(l.ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".tmp-")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(value); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), l.path)
+}
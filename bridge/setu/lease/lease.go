@@ -0,0 +1,82 @@
+package lease
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// DefaultTCPAddress is the address the tcp lease listens on if none is configured
+const DefaultTCPAddress = "127.0.0.1:26690"
+
+// Lease is held by the active instance of the bridge, the other instances
+// wait on standby until it expires or is released
+type Lease interface {
+	// Acquire blocks until the lease is held or the context is done
+	Acquire(ctx context.Context) error
+	// Lost is closed once the lease is no longer held, after it was acquired
+	Lost() <-chan struct{}
+	// Release gives up the lease
+	Release() error
+}
+
+// NewLease returns the configured lease, or nil when high availability is disabled
+func NewLease() (Lease, error) {
+	logger := util.Logger().With("module", "lease")
+	config := helper.GetConfig()
+
+	switch config.BridgeLeaseBackend {
+	case "":
+		return nil, nil
+	case helper.FileBridgeLease:
+		path := config.BridgeLeasePath
+		if path == "" {
+			path = viper.GetString(util.BridgeDBFlag) + ".lease"
+		}
+
+		return NewFileLease(logger, path, ownerID(), config.BridgeLeaseTTL), nil
+	case helper.TCPBridgeLease:
+		address := config.BridgeLeasePath
+		if address == "" {
+			address = DefaultTCPAddress
+		}
+
+		return NewTCPLease(logger, address, config.BridgeLeaseTTL), nil
+	default:
+		return nil, fmt.Errorf("unknown bridge lease backend %v", config.BridgeLeaseBackend)
+	}
+}
+
+// ownerID identifies this bridge instance in the lease
+func ownerID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano())
+}
+
+// retryInterval is the interval between two attempts to acquire or renew the lease
+func retryInterval(ttl time.Duration) time.Duration {
+	return ttl / 3
+}
+
+// waitRetry waits for the next attempt, it returns false if the context is done
+func waitRetry(ctx context.Context, logger log.Logger, ttl time.Duration) bool {
+	logger.Debug("Bridge lease held by another instance, waiting on standby")
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(retryInterval(ttl)):
+		return true
+	}
+}
@@ -0,0 +1,134 @@
+package lease
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const testTTL = 300 * time.Millisecond
+
+func TestFileLease(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "bridge.lease")
+
+	active := NewFileLease(log.NewNopLogger(), path, "active", testTTL)
+	require.NoError(t, active.Acquire(context.Background()))
+
+	standby := NewFileLease(log.NewNopLogger(), path, "standby", testTTL)
+
+	// the active instance renews the lease
+	ctx, cancel := context.WithTimeout(context.Background(), 3*testTTL)
+	defer cancel()
+
+	require.ErrorIs(t, standby.Acquire(ctx), context.DeadlineExceeded, "Standby shouldn't take a renewed lease")
+
+	// the standby takes over once the lease is released
+	require.NoError(t, active.Release())
+	require.NoError(t, standby.Acquire(context.Background()))
+	require.NoError(t, standby.Release())
+}
+
+func TestFileLeaseExpiry(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "bridge.lease")
+
+	active := NewFileLease(log.NewNopLogger(), path, "active", testTTL)
+	require.NoError(t, active.Acquire(context.Background()))
+
+	// the active instance stops renewing, as if it crashed
+	active.cancel()
+	<-active.done
+
+	standby := NewFileLease(log.NewNopLogger(), path, "standby", testTTL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*testTTL)
+	defer cancel()
+
+	require.NoError(t, standby.Acquire(ctx), "Standby should take over the expired lease")
+
+	// the previous instance notices it lost the lease when it renews it
+	active.done = make(chan struct{})
+	go active.renew(context.Background())
+
+	select {
+	case <-active.Lost():
+	case <-time.After(5 * testTTL):
+		t.Fatal("Lease should be lost after the takeover")
+	}
+
+	require.NoError(t, standby.Release())
+}
+
+func TestFileLeaseConcurrentTakeover(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "bridge.lease")
+
+	var (
+		wg       sync.WaitGroup
+		acquired int32
+	)
+
+	// the instances try to take the lease at once, a single one gets it
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func(owner string) {
+			defer wg.Done()
+
+			ok, err := NewFileLease(log.NewNopLogger(), path, owner, testTTL).tryAcquire()
+			assert.NoError(t, err)
+
+			if ok {
+				atomic.AddInt32(&acquired, 1)
+			}
+		}(fmt.Sprintf("instance-%d", i))
+	}
+
+	wg.Wait()
+
+	require.Equal(t, int32(1), acquired, "A single instance should take the lease")
+}
+
+func TestTCPLease(t *testing.T) {
+	t.Parallel()
+
+	// pick a free local address
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	active := NewTCPLease(log.NewNopLogger(), address, testTTL)
+	require.NoError(t, active.Acquire(context.Background()))
+
+	standby := NewTCPLease(log.NewNopLogger(), address, testTTL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*testTTL)
+	defer cancel()
+
+	require.ErrorIs(t, standby.Acquire(ctx), context.DeadlineExceeded, "Standby shouldn't take a held lease")
+
+	require.NoError(t, active.Release())
+	require.NoError(t, standby.Acquire(context.Background()))
+	require.NoError(t, standby.Release())
+
+	select {
+	case <-active.Lost():
+		t.Fatal("Released lease shouldn't be reported as lost")
+	default:
+	}
+}
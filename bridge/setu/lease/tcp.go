@@ -0,0 +1,106 @@
+package lease
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// TCPLease is a lease held by listening on a local address, which only one
+// bridge instance can do at a time. It is released as soon as the holder
+// stops, even if it crashed. It only coordinates the bridge instances of a
+// single host, use the file lease on a shared volume across hosts.
+type TCPLease struct {
+	logger  log.Logger
+	address string
+	ttl     time.Duration
+
+	listener net.Listener
+	released bool
+	mu       sync.Mutex
+
+	lost     chan struct{}
+	lostOnce sync.Once
+}
+
+// NewTCPLease returns a lease held by listening on the address
+func NewTCPLease(logger log.Logger, address string, ttl time.Duration) *TCPLease {
+	return &TCPLease{
+		logger:  logger,
+		address: address,
+		ttl:     ttl,
+		lost:    make(chan struct{}),
+	}
+}
+
+// Acquire blocks until the lease is held or the context is done
+func (l *TCPLease) Acquire(ctx context.Context) error {
+	for {
+		listener, err := net.Listen("tcp", l.address)
+		if err == nil {
+			l.logger.Info("Acquired bridge lease", "address", l.address)
+
+			l.mu.Lock()
+			l.listener = listener
+			l.mu.Unlock()
+
+			go l.serve(listener)
+
+			return nil
+		}
+
+		if !waitRetry(ctx, l.logger, l.ttl) {
+			return ctx.Err()
+		}
+	}
+}
+
+// Lost is closed once the lease is no longer held
+func (l *TCPLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Release stops listening, so another instance can take the lease
+func (l *TCPLease) Release() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.listener == nil || l.released {
+		return nil
+	}
+
+	l.released = true
+
+	return l.listener.Close()
+}
+
+// serve closes the connections to the lease address, the lease is lost if
+// the listener fails
+func (l *TCPLease) serve(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			l.mu.Lock()
+			released := l.released
+			l.mu.Unlock()
+
+			if !released && !errors.Is(err, net.ErrClosed) {
+				l.logger.Error("Bridge lease listener failed", "address", l.address, "error", err)
+			}
+
+			if !released {
+				l.lostOnce.Do(func() {
+					close(l.lost)
+				})
+			}
+
+			return
+		}
+
+		conn.Close()
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/log"
 
-	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
@@ -31,7 +30,7 @@ type Listener interface {
 
 	StartPolling(context.Context, time.Duration, *big.Int)
 
-	StartSubscription(context.Context, ethereum.Subscription)
+	StartSubscription(context.Context, time.Duration, *big.Int)
 
 	ProcessHeader(*blockHeader)
 
@@ -53,6 +52,12 @@ type BaseListener struct {
 
 	chainClient *ethclient.Client
 
+	// websocket endpoint of the chain to subscribe to new blocks, polls if empty
+	chainWSURL string
+
+	// minimum time between the headers processed from the subscription
+	minHeaderInterval time.Duration
+
 	// header channel
 	HeaderChannel chan *blockHeader
 
@@ -81,7 +86,7 @@ type blockHeader struct {
 }
 
 // NewBaseListener creates a new BaseListener.
-func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, chainClient *ethclient.Client, name string, impl Listener) *BaseListener {
+func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, chainClient *ethclient.Client, chainWSURL string, name string, impl Listener) *BaseListener {
 	logger := util.Logger().With("service", "listener", "module", name)
 
 	contractCaller, err := helper.NewContractCaller()
@@ -107,6 +112,7 @@ func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, htt
 		httpClient:        httpClient,
 		contractConnector: contractCaller,
 		chainClient:       chainClient,
+		chainWSURL:        chainWSURL,
 
 		HeaderChannel: make(chan *blockHeader),
 	}
@@ -154,6 +160,8 @@ func (bl *BaseListener) StartHeaderProcess(ctx context.Context) {
 	for {
 		select {
 		case newHeader := <-bl.HeaderChannel:
+			// headers come from polling or a subscription, both report the head
+			util.SetListenerHead(bl.name, newHeader.header.Number.Uint64())
 			bl.impl.ProcessHeader(newHeader)
 		case <-ctx.Done():
 			bl.Logger.Info("Header process stopped")
@@ -171,38 +179,20 @@ func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Dura
 	// the ending of the interval
 	ticker := time.NewTicker(interval)
 
+	util.SetListenerMode(bl.name, util.ListenerModePolling, interval)
+
 	// start listening
 	for {
 		select {
 		case <-ticker.C:
-			var bHeader *blockHeader
-
-			header, err := bl.chainClient.HeaderByNumber(ctx, number)
-			if err == nil && header != nil {
-				if number != nil {
-					// finalized was requested
-					bHeader = &blockHeader{header: header, isFinalized: true}
-				} else {
-					// latest was requested
-					bHeader = &blockHeader{header: header, isFinalized: false}
-				}
-			}
-
-			// if error occurred and finalized was requested, fall back to latest block
-			if err != nil && number != nil {
-				header, err = bl.chainClient.HeaderByNumber(ctx, nil)
-				if err == nil && header != nil {
-					bHeader = &blockHeader{header: header, isFinalized: false}
-				}
-			}
-
+			bHeader, err := bl.fetchHeader(ctx, number)
 			if err != nil {
 				bl.Logger.Error("Error in fetching block header while polling", "err", err)
 			}
 
 			// push data to the channel
 			if bHeader != nil {
-				bl.HeaderChannel <- bHeader
+				bl.pushHeader(ctx, bHeader)
 			}
 		case <-ctx.Done():
 			bl.Logger.Info("Polling stopped")
@@ -213,26 +203,53 @@ func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Dura
 	}
 }
 
-func (bl *BaseListener) StartSubscription(ctx context.Context, subscription ethereum.Subscription) {
-	for {
-		select {
-		case err := <-subscription.Err():
-			// stop service
-			bl.Logger.Error("Error while subscribing new blocks", "error", err)
-			// bl.Stop()
-
-			// cancel subscription
-			if bl.cancelSubscription != nil {
-				bl.Logger.Debug("Cancelling the subscription of listener")
-				bl.cancelSubscription()
-			}
+// fetchHeader fetches the block header of the number, or the latest one if
+// the number is nil or its block is not available
+func (bl *BaseListener) fetchHeader(ctx context.Context, number *big.Int) (*blockHeader, error) {
+	var bHeader *blockHeader
+
+	header, err := bl.chainClient.HeaderByNumber(ctx, number)
+	if err == nil && header != nil {
+		if number != nil {
+			// finalized was requested
+			bHeader = &blockHeader{header: header, isFinalized: true}
+		} else {
+			// latest was requested
+			bHeader = &blockHeader{header: header, isFinalized: false}
+		}
+	}
 
-			return
-		case <-ctx.Done():
-			bl.Logger.Info("Subscription stopped")
-			return
+	// if error occurred and finalized was requested, fall back to latest block
+	if err != nil && number != nil {
+		header, err = bl.chainClient.HeaderByNumber(ctx, nil)
+		if err == nil && header != nil {
+			bHeader = &blockHeader{header: header, isFinalized: false}
 		}
 	}
+
+	return bHeader, err
+}
+
+// pushHeader sends the header to the header process, unless the context is done first
+func (bl *BaseListener) pushHeader(ctx context.Context, bHeader *blockHeader) bool {
+	select {
+	case bl.HeaderChannel <- bHeader:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// StartSubscription subscribes to the new heads through the websocket endpoint
+// of the chain, polling while the subscription is down. When number is set,
+// the header of that number is processed on each new head, as when polling.
+func (bl *BaseListener) StartSubscription(ctx context.Context, pollInterval time.Duration, number *big.Int) {
+	if bl.chainWSURL == "" {
+		bl.impl.StartPolling(ctx, pollInterval, number)
+		return
+	}
+
+	bl.superviseSubscription(ctx, bl.subscribeNewHeads(number), pollInterval, number)
 }
 
 // Stop stops all necessary go routines
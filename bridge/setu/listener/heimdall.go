@@ -4,12 +4,17 @@ import (
 	"context"
 	"math/big"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/event"
 	jsoniter "github.com/json-iterator/go"
+	tmTypes "github.com/tendermint/tendermint/types"
 
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
@@ -17,11 +22,20 @@ import (
 
 const (
 	heimdallLastBlockKey = "heimdall-last-block" // storage key
+
+	// subscriber of the tendermint new block events
+	heimdallSubscriber = "bridge-heimdall-listener"
+
+	// timeout to unsubscribe from the tendermint new block events
+	heimdallUnsubscribeTimeout = 5 * time.Second
 )
 
 // HeimdallListener - Listens to and process events from heimdall
 type HeimdallListener struct {
 	BaseListener
+
+	// polling and the subscription process the new blocks one at a time
+	processMu sync.Mutex
 }
 
 // NewHeimdallListener - constructor func
@@ -34,8 +48,8 @@ func (hl *HeimdallListener) Start() error {
 	hl.Logger.Info("Starting")
 
 	// create cancellable context
-	headerCtx, cancelHeaderProcess := context.WithCancel(context.Background())
-	hl.cancelHeaderProcess = cancelHeaderProcess
+	ctx, cancelSubscription := context.WithCancel(context.Background())
+	hl.cancelSubscription = cancelSubscription
 
 	// Heimdall pollIntervall = (minimal pollInterval of rootchain and matichain)
 	pollInterval := helper.GetConfig().SyncerPollInterval
@@ -43,8 +57,8 @@ func (hl *HeimdallListener) Start() error {
 		pollInterval = helper.GetConfig().CheckpointerPollInterval
 	}
 
-	hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
-	hl.StartPolling(headerCtx, pollInterval, nil)
+	hl.Logger.Info("Start subscribing for events", "pollInterval", pollInterval)
+	go hl.StartSubscription(ctx, pollInterval, nil)
 
 	return nil
 }
@@ -63,6 +77,8 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	// the ending of the interval
 	ticker := time.NewTicker(interval)
 
+	util.SetListenerMode(hl.name, util.ListenerModePolling, interval)
+
 	// var eventTypes []string
 	// eventTypes = append(eventTypes, "message.action='checkpoint'")
 	// eventTypes = append(eventTypes, "message.action='event-record'")
@@ -73,87 +89,161 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	for {
 		select {
 		case <-ticker.C:
-			fromBlock, toBlock, err := hl.fetchFromAndToBlock()
+			toBlock, err := hl.fetchLatestBlock()
 			if err != nil {
-				hl.Logger.Error("Error fetching from and toBlock, skipping events query", "fromBlock", fromBlock, "toBlock", toBlock, "error", err)
-			} else if fromBlock < toBlock {
+				hl.Logger.Error("Error fetching toBlock, skipping events query", "error", err)
+				break
+			}
 
-				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+			hl.processNewBlocks(toBlock)
 
-				// Querying and processing Begin events
-				for i := fromBlock; i <= toBlock; i++ {
-					// nolint: contextcheck
-					events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
-					if err != nil {
-						hl.Logger.Error("Error fetching begin block events", "error", err)
-					}
-					for _, event := range events {
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
-					}
+		case <-ctx.Done():
+			hl.Logger.Info("Polling stopped")
+			ticker.Stop()
+
+			return
+		}
+	}
+}
+
+// StartSubscription subscribes to the new heimdall blocks through tendermint,
+// polling while the subscription is down
+func (hl *HeimdallListener) StartSubscription(ctx context.Context, pollInterval time.Duration, _ *big.Int) {
+	hl.superviseSubscription(ctx, hl.subscribeNewBlocks, pollInterval, nil)
+}
+
+// subscribeNewBlocks returns a subscription to the tendermint new block
+// events, processing the blocks up to each new one
+func (hl *HeimdallListener) subscribeNewBlocks(ctx context.Context, alive chan<- struct{}) (ethereum.Subscription, error) {
+	query := tmTypes.EventQueryNewBlock.String()
+
+	blocks, err := hl.httpClient.Subscribe(ctx, heimdallSubscriber, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer func() {
+			unsubscribeCtx, cancel := context.WithTimeout(context.Background(), heimdallUnsubscribeTimeout)
+			defer cancel()
+
+			if err := hl.httpClient.Unsubscribe(unsubscribeCtx, heimdallSubscriber, query); err != nil {
+				hl.Logger.Debug("Error unsubscribing from new blocks", "error", err)
+			}
+		}()
+
+		for {
+			select {
+			case result, ok := <-blocks:
+				if !ok {
+					return errSubscriptionClosed
 				}
 
-				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
-				/* 		for _, eventType := range eventTypes {
-					var query []string
-					query = append(query, eventType)
-					query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
-					query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
-
-					limit := 50
-					for page := 1; page > 0; {
-						searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
-						hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
-
-						if err != nil {
-							hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
-							break
-						}
+				newBlock, ok := result.Data.(tmTypes.EventDataNewBlock)
+				if !ok || newBlock.Block == nil {
+					continue
+				}
 
-						for _, tx := range searchResult.Txs {
-							for _, log := range tx.Logs {
-								event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
-									return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
-								})
-								if event != nil {
-									hl.ProcessEvent(*event, tx)
-								}
-							}
-						}
+				notifyAlive(alive)
+				hl.processNewBlocks(uint64(newBlock.Block.Height))
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// processNewBlocks processes the events of the blocks after the last processed one, up to toBlock
+func (hl *HeimdallListener) processNewBlocks(toBlock uint64) {
+	hl.processMu.Lock()
+	defer hl.processMu.Unlock()
 
-						if len(searchResult.Txs) == limit {
-							page = page + 1
-						} else {
-							page = 0
+	fromBlock, err := hl.fetchFromBlock()
+	if err != nil {
+		hl.Logger.Error("Error fetching fromBlock, skipping events query", "toBlock", toBlock, "error", err)
+		return
+	}
+
+	util.SetListenerHead(hl.name, toBlock)
+
+	if fromBlock >= toBlock {
+		if fromBlock > 0 {
+			util.SetListenerProcessed(hl.name, fromBlock-1)
+		}
+	} else {
+
+		hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+
+		// Querying and processing Begin events
+		for i := fromBlock; i <= toBlock; i++ {
+			// nolint: contextcheck
+			events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
+			if err != nil {
+				hl.Logger.Error("Error fetching begin block events", "error", err)
+			}
+			for _, event := range events {
+				hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
+			}
+		}
+
+		// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
+		/* 		for _, eventType := range eventTypes {
+			var query []string
+			query = append(query, eventType)
+			query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
+			query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
+
+			limit := 50
+			for page := 1; page > 0; {
+				searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
+				hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
+
+				if err != nil {
+					hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
+					break
+				}
+
+				for _, tx := range searchResult.Txs {
+					for _, log := range tx.Logs {
+						event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
+							return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
+						})
+						if event != nil {
+							hl.ProcessEvent(*event, tx)
 						}
 					}
-				} */
-				// set last block to storage
-				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
-					hl.Logger.Error("hl.storageClient.Put", "Error", err)
 				}
-			}
 
-		case <-ctx.Done():
-			hl.Logger.Info("Polling stopped")
-			ticker.Stop()
-
-			return
+				if len(searchResult.Txs) == limit {
+					page = page + 1
+				} else {
+					page = 0
+				}
+			}
+		} */
+		// set last block to storage
+		if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
+			hl.Logger.Error("hl.storageClient.Put", "Error", err)
 		}
+
+		util.SetListenerProcessed(hl.name, toBlock)
 	}
 }
 
-func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
-	// toBlock - get latest blockheight from heimdall node
-	fromBlock := uint64(0)
-	toBlock := uint64(0)
-
+// fetchLatestBlock returns the latest blockheight from heimdall node
+func (hl *HeimdallListener) fetchLatestBlock() (uint64, error) {
 	nodeStatus, err := helper.GetNodeStatus(hl.cliCtx)
 	if err != nil {
 		hl.Logger.Error("Error while fetching heimdall node status", "error", err)
-		return fromBlock, toBlock, err
+		return 0, err
 	}
 
-	toBlock = uint64(nodeStatus.SyncInfo.LatestBlockHeight)
+	return uint64(nodeStatus.SyncInfo.LatestBlockHeight), nil
+}
+
+// fetchFromBlock returns the block after the last one processed, from storage
+func (hl *HeimdallListener) fetchFromBlock() (uint64, error) {
+	fromBlock := uint64(0)
 
 	// fromBlock - get last block from storage
 	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
@@ -161,21 +251,20 @@ func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 		lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
 		if err != nil {
 			hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return fromBlock, toBlock, err
+			return fromBlock, err
 		}
 
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = result + 1
-		} else {
+		result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64)
+		if err != nil {
 			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-
-			return fromBlock, toBlock, err
+			return fromBlock, err
 		}
+
+		hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
+		fromBlock = result + 1
 	}
 
-	return fromBlock, toBlock, err
+	return fromBlock, nil
 }
 
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
@@ -220,7 +309,7 @@ func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, bl
 	hl.Logger.Info("Sending block level task", "taskName", taskName, "currentTime", time.Now(), "blockHeight", blockHeight)
 
 	// send task
-	_, err := hl.queueConnector.Server.SendTask(signature)
+	err := hl.queueConnector.SendTask(signature)
 	if err != nil {
 		hl.Logger.Error("Error sending block level task", "taskName", taskName, "blockHeight", blockHeight, "error", err)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 )
 
@@ -34,11 +35,14 @@ func (ml *MaticChainListener) Start() error {
 	// start header process
 	go ml.StartHeaderProcess(headerCtx)
 
-	// start go routine to poll for new header using client object
-	ml.Logger.Info("Start polling for header blocks", "pollInterval", helper.GetConfig().CheckpointerPollInterval)
+	// start go routine to subscribe for new header, polling using client object as fallback
+	ml.Logger.Info("Start subscribing for header blocks", "pollInterval", helper.GetConfig().CheckpointerPollInterval)
 
-	// start polling for the latest block in child chain (replace with finalized block once we have it implemented)
-	go ml.StartPolling(ctx, helper.GetConfig().CheckpointerPollInterval, nil)
+	// new heads are processed as often as they were polled, a checkpoint doesn't need more
+	ml.minHeaderInterval = helper.GetConfig().CheckpointerPollInterval
+
+	// subscribe for the latest block in child chain (replace with finalized block once we have it implemented)
+	go ml.StartSubscription(ctx, helper.GetConfig().CheckpointerPollInterval, nil)
 
 	return nil
 }
@@ -54,6 +58,8 @@ func (ml *MaticChainListener) ProcessHeader(newHeader *blockHeader) {
 	}
 
 	ml.sendTaskWithDelay("sendCheckpointToHeimdall", headerBytes, 0)
+
+	util.SetListenerProcessed(ml.name, newHeader.header.Number.Uint64())
 }
 
 func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, delay time.Duration) {
@@ -75,7 +81,7 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 
 	ml.Logger.Debug("Sending task", "taskname", taskName, "currentTime", time.Now(), "delayTime", eta)
 
-	_, err := ml.queueConnector.Server.SendTask(signature)
+	err := ml.queueConnector.SendTask(signature)
 	if err != nil {
 		ml.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
@@ -10,6 +10,7 @@ import (
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
@@ -31,8 +32,8 @@ type RootChainListener struct {
 	stakingInfoAbi *abi.ABI
 	stateSenderAbi *abi.ABI
 
-	// For self-heal, Will be only initialised if sub_graph_url is provided
-	subGraphClient *subGraphClient
+	// For self-heal, only initialised if self-healing is enabled
+	selfHealBackend selfHealBackend
 }
 
 const (
@@ -74,11 +75,11 @@ func (rl *RootChainListener) Start() error {
 	// start header process
 	go rl.StartHeaderProcess(headerCtx)
 
-	// start go routine to poll for new header using client object
-	rl.Logger.Info("Start polling for rootchain header blocks", "pollInterval", helper.GetConfig().SyncerPollInterval)
+	// start go routine to subscribe for new header, polling using client object as fallback
+	rl.Logger.Info("Start subscribing for rootchain header blocks", "pollInterval", helper.GetConfig().SyncerPollInterval)
 
-	// start polling for the finalized block in main chain (available post-merge)
-	go rl.StartPolling(ctx, helper.GetConfig().SyncerPollInterval, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	// process the finalized block in main chain (available post-merge) on each new head
+	go rl.StartSubscription(ctx, helper.GetConfig().SyncerPollInterval, big.NewInt(int64(rpc.FinalizedBlockNumber)))
 
 	// Start self-healing process
 	go rl.startSelfHealing(ctx)
@@ -100,12 +101,26 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 	headerNumber := newHeader.header.Number
 	from := headerNumber
 
-	// If incoming header is a `finalized` header, it can directly be considered as
-	// the upper cap (i.e. the `to` value)
+	finality := rootchainContext.ChainmanagerParams.GetMainchainFinality()
+
+	// In the `finalized` and `safe` finality modes, the block of the tag is the
+	// upper cap and the confirmations aren't relied on.
+	//
+	// Otherwise, if incoming header is a `finalized` header, it can directly be
+	// considered as the upper cap (i.e. the `to` value)
 	//
 	// If incoming header is a `latest` header, rely on `requiredConfirmations` to get
 	// finalized block range.
-	if !newHeader.isFinalized {
+	if finality != chainmanagerTypes.MainchainFinalityConfirmations {
+		taggedHeader, err := rl.getTaggedHeader(newHeader, finality)
+		if err != nil {
+			rl.Logger.Error("Error while fetching rootchain block of the finality mode", "finality", finality, "error", err)
+			return
+		}
+
+		headerNumber = new(big.Int).Set(taggedHeader.Number)
+		from = headerNumber
+	} else if !newHeader.isFinalized {
 		// This check is only useful when the L1 blocks received are < requiredConfirmations
 		// just for the below headerNumber -= requiredConfirmations math operation
 		confirmationBlocks := big.NewInt(0).SetUint64(requiredConfirmations)
@@ -134,6 +149,7 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 
 		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
 			if result >= headerNumber.Uint64() {
+				util.SetListenerProcessed(rl.name, result)
 				return
 			}
 
@@ -148,17 +164,53 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 		from = to
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), rl.contractConnector.MainChainTimeout)
+	defer cancel()
+
+	// scan the blocks again from the common ancestor if the chain reorganized
+	rescanFrom, err := rl.rewindOnReorg(ctx, rpcHeaderReader{client: rl.contractConnector.MainChainRPC}, from.Uint64())
+	if err != nil {
+		rl.Logger.Error("Error while checking rootchain reorg", "error", err)
+		return
+	}
+
+	from = big.NewInt(0).SetUint64(rescanFrom)
+
 	// Set last block to storage
 	if err = rl.storageClient.Put([]byte(lastRootBlockKey), []byte(to.String()), nil); err != nil {
 		rl.Logger.Error("rl.storageClient.Put", "Error", err)
 	}
 
 	// Handle events
-	rl.queryAndBroadcastEvents(rootchainContext, from, to)
+	logs, err := rl.queryAndBroadcastEvents(rootchainContext, from, to)
+	if err == nil {
+		recordCtx, cancelRecord := context.WithTimeout(context.Background(), rl.contractConnector.MainChainTimeout)
+		defer cancelRecord()
+
+		if err := rl.recordRootBlocks(recordCtx, rpcHeaderReader{client: rl.contractConnector.MainChainRPC}, from.Uint64(), to.Uint64(), logs); err != nil {
+			rl.Logger.Error("Error while recording rootchain blocks", "error", err)
+		}
+	}
+
+	util.SetListenerProcessed(rl.name, to.Uint64())
+}
+
+// getTaggedHeader returns the rootchain header of the finality mode, which
+// is the incoming header itself when it is the finalized one
+func (rl *RootChainListener) getTaggedHeader(newHeader *blockHeader, finality string) (*types.Header, error) {
+	if finality == chainmanagerTypes.MainchainFinalitySafe {
+		return rl.contractConnector.GetMainChainSafeBlock()
+	}
+
+	if newHeader.isFinalized {
+		return newHeader.header, nil
+	}
+
+	return rl.contractConnector.GetMainChainFinalizedBlock()
 }
 
 // queryAndBroadcastEvents fetches supported events from the rootchain and handles all of them
-func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) {
+func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) ([]types.Log, error) {
 	rl.Logger.Info("Query rootchain event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
 	ctx, cancel := context.WithTimeout(context.Background(), rl.contractConnector.MainChainTimeout)
@@ -179,7 +231,7 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 	})
 	if err != nil {
 		rl.Logger.Error("Error while filtering logs", "error", err)
-		return
+		return nil, err
 	} else if len(logs) > 0 {
 		rl.Logger.Debug("New logs found", "numberOfLogs", len(logs))
 	}
@@ -196,6 +248,8 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 			rl.handleLog(vLog, selectedEvent)
 		}
 	}
+
+	return logs, nil
 }
 
 func (rl *RootChainListener) SendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration, event interface{}) {
@@ -221,7 +275,7 @@ func (rl *RootChainListener) SendTaskWithDelay(taskName string, eventName string
 	signature.ETA = &eta
 	rl.Logger.Info("Sending task", "taskName", taskName, "currentTime", time.Now(), "delayTime", eta)
 
-	_, err := rl.queueConnector.Server.SendTask(signature)
+	err := rl.queueConnector.SendTask(signature)
 	if err != nil {
 		rl.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
@@ -0,0 +1,249 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/syndtr/goleveldb/leveldb"
+	dbUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// storage key prefix of the processed rootchain blocks, followed by their number
+	rootBlockKeyPrefix = "rootchain-block-"
+
+	// number of processed rootchain blocks kept to detect reorgs
+	rootBlockHistory = 128
+)
+
+var (
+	rootChainReorgCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_reorgs",
+		Help:      "The total number of rootchain reorgs detected by the listener",
+	})
+
+	rootChainRemovedLogsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_removed_logs",
+		Help:      "The total number of processed rootchain logs removed by a reorg",
+	})
+)
+
+// rootHeaderReader reads the rootchain headers of several blocks at once
+type rootHeaderReader interface {
+	HeadersByNumber(ctx context.Context, numbers []uint64) ([]*types.Header, error)
+}
+
+// rpcHeaderReader reads the rootchain headers with a single batch call
+type rpcHeaderReader struct {
+	client *rpc.Client
+}
+
+// HeadersByNumber returns the headers of the blocks, in the same order
+func (r rpcHeaderReader) HeadersByNumber(ctx context.Context, numbers []uint64) ([]*types.Header, error) {
+	headers := make([]*types.Header, len(numbers))
+	batch := make([]rpc.BatchElem, len(numbers))
+
+	for i, number := range numbers {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeUint64(number), false},
+			Result: &headers[i],
+		}
+	}
+
+	if err := r.client.BatchCallContext(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+
+		if headers[i] == nil {
+			return nil, fmt.Errorf("rootchain block %d not found", numbers[i])
+		}
+	}
+
+	return headers, nil
+}
+
+// rootBlock is a processed rootchain block
+type rootBlock struct {
+	Hash ethCommon.Hash `json:"hash"`
+	Logs int            `json:"logs"`
+}
+
+// rewindOnReorg checks the parent of the first block to scan against the
+// last processed block. If the chain reorganized, it walks back to the common
+// ancestor and returns the block after it, to scan the new blocks again.
+func (rl *RootChainListener) rewindOnReorg(ctx context.Context, client rootHeaderReader, from uint64) (uint64, error) {
+	if from == 0 {
+		return from, nil
+	}
+
+	last, err := rl.getRootBlock(from - 1)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		// nothing recorded yet
+		return from, nil
+	} else if err != nil {
+		return from, err
+	}
+
+	headers, err := client.HeadersByNumber(ctx, []uint64{from})
+	if err != nil {
+		return from, err
+	}
+
+	if headers[0].ParentHash == last.Hash {
+		return from, nil
+	}
+
+	// recorded blocks, from the last one back to the oldest one
+	var recorded []*rootBlock
+
+	for number := from - 1; ; number-- {
+		block, err := rl.getRootBlock(number)
+		if errors.Is(err, leveldb.ErrNotFound) {
+			break
+		} else if err != nil {
+			return from, err
+		}
+
+		recorded = append(recorded, block)
+
+		if number == 0 {
+			break
+		}
+	}
+
+	numbers := make([]uint64, len(recorded))
+	for i := range recorded {
+		numbers[i] = from - 1 - uint64(i)
+	}
+
+	canonical, err := client.HeadersByNumber(ctx, numbers)
+	if err != nil {
+		return from, err
+	}
+
+	// walk back to the last block still in the chain
+	var (
+		depth       uint64
+		removedLogs int
+	)
+
+	for i, block := range recorded {
+		if canonical[i].Hash() == block.Hash {
+			break
+		}
+
+		if err := rl.storageClient.Delete(rootBlockKey(numbers[i]), nil); err != nil {
+			return from, err
+		}
+
+		depth++
+		removedLogs += block.Logs
+	}
+
+	if depth == uint64(len(recorded)) && numbers[len(numbers)-1] > 0 {
+		rl.Logger.Error("Rootchain reorg deeper than the recorded blocks", "oldestReorgedBlock", from-depth)
+	}
+
+	rescanFrom := from - depth
+
+	rl.Logger.Info("Rootchain reorg detected, scanning again from the common ancestor", "depth", depth, "fromBlock", rescanFrom, "removedLogs", removedLogs)
+
+	rootChainReorgCounter.Inc()
+	rootChainRemovedLogsCounter.Add(float64(removedLogs))
+
+	return rescanFrom, nil
+}
+
+// recordRootBlocks stores the hashes of the last scanned blocks along with
+// the number of logs processed in each, and prunes the older ones
+func (rl *RootChainListener) recordRootBlocks(ctx context.Context, client rootHeaderReader, from uint64, to uint64, logs []types.Log) error {
+	if to+1 > rootBlockHistory && from < to+1-rootBlockHistory {
+		from = to + 1 - rootBlockHistory
+	}
+
+	logsPerBlock := make(map[uint64]int)
+	for _, vLog := range logs {
+		logsPerBlock[vLog.BlockNumber]++
+	}
+
+	numbers := make([]uint64, 0, to-from+1)
+	for number := from; number <= to; number++ {
+		numbers = append(numbers, number)
+	}
+
+	headers, err := client.HeadersByNumber(ctx, numbers)
+	if err != nil {
+		return err
+	}
+
+	batch := new(leveldb.Batch)
+
+	for i, number := range numbers {
+		value, err := json.Marshal(rootBlock{
+			Hash: headers[i].Hash(),
+			Logs: logsPerBlock[number],
+		})
+		if err != nil {
+			return err
+		}
+
+		batch.Put(rootBlockKey(number), value)
+	}
+
+	if to+1 > rootBlockHistory {
+		iter := rl.storageClient.NewIterator(&dbUtil.Range{
+			Start: []byte(rootBlockKeyPrefix),
+			Limit: rootBlockKey(to + 1 - rootBlockHistory),
+		}, nil)
+
+		for iter.Next() {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+
+		iter.Release()
+
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	return rl.storageClient.Write(batch, nil)
+}
+
+func (rl *RootChainListener) getRootBlock(number uint64) (*rootBlock, error) {
+	value, err := rl.storageClient.Get(rootBlockKey(number), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var block rootBlock
+	if err := json.Unmarshal(value, &block); err != nil {
+		return nil, err
+	}
+
+	return &block, nil
+}
+
+func rootBlockKey(number uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", rootBlockKeyPrefix, number))
+}
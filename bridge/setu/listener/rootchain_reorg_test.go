@@ -0,0 +1,203 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeHeaderReader is a rootchain where the blocks after a fork point can be replaced
+type fakeHeaderReader struct {
+	headers []*types.Header
+	calls   int
+}
+
+// newFakeHeaderReader returns a chain of n blocks
+func newFakeHeaderReader(n uint64) *fakeHeaderReader {
+	r := &fakeHeaderReader{}
+	r.extend(n, 0)
+
+	return r
+}
+
+// extend adds blocks up to n, the fork id makes their hashes differ
+func (r *fakeHeaderReader) extend(n uint64, fork byte) {
+	for number := uint64(len(r.headers)); number < n; number++ {
+		header := &types.Header{
+			Number:     new(big.Int).SetUint64(number),
+			Difficulty: big.NewInt(1),
+			Extra:      []byte{fork},
+		}
+
+		if number > 0 {
+			header.ParentHash = r.headers[number-1].Hash()
+		}
+
+		r.headers = append(r.headers, header)
+	}
+}
+
+// reorg replaces the blocks from the fork point
+func (r *fakeHeaderReader) reorg(forkPoint uint64, n uint64, fork byte) {
+	r.headers = r.headers[:forkPoint]
+	r.extend(n, fork)
+}
+
+func (r *fakeHeaderReader) HeadersByNumber(_ context.Context, numbers []uint64) ([]*types.Header, error) {
+	r.calls++
+
+	headers := make([]*types.Header, len(numbers))
+	for i, number := range numbers {
+		headers[i] = r.headers[number]
+	}
+
+	return headers, nil
+}
+
+func newTestRootChainListener(t *testing.T) *RootChainListener {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	rl := &RootChainListener{}
+	rl.Logger = log.NewNopLogger()
+	rl.storageClient = db
+
+	return rl
+}
+
+func TestRewindOnReorg(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	chain := newFakeHeaderReader(20)
+	rl := newTestRootChainListener(t)
+
+	// nothing recorded yet
+	from, err := rl.rewindOnReorg(ctx, chain, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), from)
+
+	logs := []types.Log{{BlockNumber: 15}, {BlockNumber: 17}, {BlockNumber: 17}}
+	require.NoError(t, rl.recordRootBlocks(ctx, chain, 10, 19, logs))
+	require.Equal(t, 1, chain.calls, "Blocks should be recorded with a single batch call")
+
+	// the chain moves on
+	chain.extend(25, 0)
+
+	from, err = rl.rewindOnReorg(ctx, chain, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), from, "There should be no rewind without reorg")
+
+	// blocks from 16 are replaced
+	chain.reorg(16, 25, 1)
+	chain.calls = 0
+
+	from, err = rl.rewindOnReorg(ctx, chain, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), from, "It should scan again from the block after the common ancestor")
+	require.Equal(t, 2, chain.calls, "The walk back should fetch the recorded blocks with a single batch call")
+
+	_, err = rl.getRootBlock(16)
+	require.ErrorIs(t, err, leveldb.ErrNotFound, "Reorged blocks should be forgotten")
+
+	block, err := rl.getRootBlock(15)
+	require.NoError(t, err)
+	require.Equal(t, chain.headers[15].Hash(), block.Hash)
+	require.Equal(t, 1, block.Logs)
+}
+
+func TestRewindOnReorgBeyondHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	chain := newFakeHeaderReader(20)
+	rl := newTestRootChainListener(t)
+
+	require.NoError(t, rl.recordRootBlocks(ctx, chain, 15, 19, nil))
+
+	// blocks from 10 are replaced, before the recorded ones
+	chain.reorg(10, 25, 1)
+
+	from, err := rl.rewindOnReorg(ctx, chain, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), from, "It should scan again from the oldest recorded block")
+}
+
+func TestRecordRootBlocksPrunes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	chain := newFakeHeaderReader(rootBlockHistory + 50)
+	rl := newTestRootChainListener(t)
+
+	require.NoError(t, rl.recordRootBlocks(ctx, chain, 0, 20, nil))
+
+	to := uint64(rootBlockHistory + 49)
+	require.NoError(t, rl.recordRootBlocks(ctx, chain, 21, to, nil))
+
+	_, err := rl.getRootBlock(10)
+	require.ErrorIs(t, err, leveldb.ErrNotFound, "Blocks older than the history should be pruned")
+
+	_, err = rl.getRootBlock(to - rootBlockHistory)
+	require.ErrorIs(t, err, leveldb.ErrNotFound, "Blocks older than the history shouldn't be recorded")
+
+	_, err = rl.getRootBlock(to + 1 - rootBlockHistory)
+	require.NoError(t, err)
+}
+
+func TestRPCHeaderReader(t *testing.T) {
+	t.Parallel()
+
+	chain := newFakeHeaderReader(5)
+
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		var batch []struct {
+			ID     json.RawMessage   `json:"id"`
+			Params []json.RawMessage `json:"params"`
+		}
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+
+		results := make([]map[string]interface{}, len(batch))
+
+		for i, req := range batch {
+			var number hexutil.Uint64
+			require.NoError(t, json.Unmarshal(req.Params[0], &number))
+
+			results[i] = map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": chain.headers[number]}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(results))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := rpc.Dial(server.URL)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	headers, err := rpcHeaderReader{client: client}.HeadersByNumber(context.Background(), []uint64{3, 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, requests, "Headers should be fetched with a single batch call")
+	require.Equal(t, chain.headers[3].Hash(), headers[0].Hash())
+	require.Equal(t, chain.headers[1].Hash(), headers[1].Hash())
+}
@@ -0,0 +1,302 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// storage keys of the log scanner
+	selfHealLastBlockKey         = "self-heal-last-block"
+	selfHealStateSyncedKeyPrefix = "self-heal-state-synced-"
+	selfHealStakeUpdateKeyPrefix = "self-heal-stake-update-"
+
+	// max number of eth_getLogs calls per scan, the rest is scanned next time
+	logScanMaxRanges = 100
+
+	// min time between two scans
+	logScanMinInterval = time.Minute
+)
+
+// logScanner finds StateSynced and StakeUpdate events by scanning the L1 logs
+// of the StateSender and StakingInfo contracts in bounded block ranges. The
+// events found are indexed in the bridge db, along with the last scanned
+// block, so each block is only scanned once.
+type logScanner struct {
+	logger log.Logger
+	db     *leveldb.DB
+	client ethereum.LogFilterer
+
+	// finalizedBlock returns the last L1 block to scan
+	finalizedBlock func() (uint64, error)
+	// addresses returns the StateSender and StakingInfo contract addresses
+	addresses func() (common.Address, common.Address, error)
+
+	stateSyncedID common.Hash
+	stakeUpdateID common.Hash
+
+	scanRange  uint64
+	startBlock uint64
+
+	mu       sync.Mutex
+	lastScan time.Time
+}
+
+func newLogScanner(rl *RootChainListener) *logScanner {
+	return &logScanner{
+		logger: rl.Logger.With("selfHeal", "logScanner"),
+		db:     rl.storageClient,
+		client: rl.contractConnector.MainChainClient,
+		finalizedBlock: func() (uint64, error) {
+			header, err := rl.contractConnector.GetMainChainFinalizedBlock()
+			if err != nil {
+				return 0, err
+			}
+
+			return header.Number.Uint64(), nil
+		},
+		addresses: func() (common.Address, common.Address, error) {
+			rootchainContext, err := rl.getRootChainContext()
+			if err != nil {
+				return common.Address{}, common.Address{}, err
+			}
+
+			chainParams := rootchainContext.ChainmanagerParams.ChainParams
+
+			return chainParams.StateSenderAddress.EthAddress(), chainParams.StakingInfoAddress.EthAddress(), nil
+		},
+		stateSyncedID: rl.stateSenderAbi.Events["StateSynced"].ID,
+		stakeUpdateID: rl.stakingInfoAbi.Events["StakeUpdate"].ID,
+		scanRange:     helper.GetConfig().SHLogScanRange,
+		startBlock:    helper.GetConfig().SHLogScanStartBlock,
+	}
+}
+
+// getLatestStateID returns state ID from the latest StateSynced event
+func (ls *logScanner) getLatestStateID(ctx context.Context) (*big.Int, error) {
+	if err := ls.scan(ctx); err != nil {
+		return nil, err
+	}
+
+	key, err := ls.lastKey(selfHealStateSyncedKeyPrefix)
+	if err != nil || key == "" {
+		return big.NewInt(0), err
+	}
+
+	stateID, err := strconv.ParseUint(strings.TrimPrefix(key, selfHealStateSyncedKeyPrefix), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetUint64(stateID), nil
+}
+
+// getStateSync returns the StateSynced event based on the given state ID
+func (ls *logScanner) getStateSync(_ context.Context, stateId int64) (*types.Log, error) {
+	vLog, err := ls.getLog(stateSyncedKey(uint64(stateId)))
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, fmt.Errorf("no state sync found for state id %d", stateId)
+	}
+
+	return vLog, err
+}
+
+// getLatestNonce returns the nonce from the latest StakeUpdate event
+func (ls *logScanner) getLatestNonce(ctx context.Context, validatorId uint64) (uint64, error) {
+	if err := ls.scan(ctx); err != nil {
+		return 0, err
+	}
+
+	prefix := stakeUpdatePrefix(validatorId)
+
+	key, err := ls.lastKey(prefix)
+	if err != nil || key == "" {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimPrefix(key, prefix), 10, 64)
+}
+
+// getStakeUpdate returns StakeUpdate event based on the given validator ID and nonce
+func (ls *logScanner) getStakeUpdate(_ context.Context, validatorId, nonce uint64) (*types.Log, error) {
+	vLog, err := ls.getLog(stakeUpdateKey(validatorId, nonce))
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, fmt.Errorf("no stake update found for validator %d and nonce %d", validatorId, nonce)
+	}
+
+	return vLog, err
+}
+
+// scan indexes the events of the blocks finalized since the last scan
+func (ls *logScanner) scan(ctx context.Context) error {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	if time.Since(ls.lastScan) < logScanMinInterval {
+		return nil
+	}
+
+	finalized, err := ls.finalizedBlock()
+	if err != nil {
+		return err
+	}
+
+	lastBlock, found, err := ls.lastScannedBlock()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if ls.startBlock == 0 {
+			// nothing to heal before the bridge started scanning
+			ls.logger.Info("Starting log scans from the finalized block", "block", finalized)
+			ls.lastScan = time.Now()
+
+			return ls.db.Put([]byte(selfHealLastBlockKey), []byte(strconv.FormatUint(finalized, 10)), nil)
+		}
+
+		lastBlock = ls.startBlock - 1
+	}
+
+	stateSender, stakingInfo, err := ls.addresses()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < logScanMaxRanges && lastBlock < finalized; i++ {
+		from := lastBlock + 1
+
+		to := from + ls.scanRange - 1
+		if to > finalized {
+			to = finalized
+		}
+
+		logs, err := ls.client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{stateSender, stakingInfo},
+			Topics:    [][]common.Hash{{ls.stateSyncedID, ls.stakeUpdateID}},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := ls.index(logs, to); err != nil {
+			return err
+		}
+
+		ls.logger.Debug("Scanned L1 logs", "fromBlock", from, "toBlock", to, "logs", len(logs))
+
+		lastBlock = to
+	}
+
+	if lastBlock >= finalized {
+		ls.lastScan = time.Now()
+	}
+
+	return nil
+}
+
+// index stores the events along with the last scanned block
+func (ls *logScanner) index(logs []types.Log, lastBlock uint64) error {
+	batch := new(leveldb.Batch)
+
+	for i := range logs {
+		vLog := logs[i]
+		if vLog.Removed {
+			continue
+		}
+
+		var key []byte
+
+		switch {
+		case len(vLog.Topics) >= 2 && vLog.Topics[0] == ls.stateSyncedID:
+			key = stateSyncedKey(new(big.Int).SetBytes(vLog.Topics[1].Bytes()).Uint64())
+		case len(vLog.Topics) >= 3 && vLog.Topics[0] == ls.stakeUpdateID:
+			key = stakeUpdateKey(
+				new(big.Int).SetBytes(vLog.Topics[1].Bytes()).Uint64(),
+				new(big.Int).SetBytes(vLog.Topics[2].Bytes()).Uint64(),
+			)
+		default:
+			continue
+		}
+
+		value, err := json.Marshal(&vLog)
+		if err != nil {
+			return err
+		}
+
+		batch.Put(key, value)
+	}
+
+	batch.Put([]byte(selfHealLastBlockKey), []byte(strconv.FormatUint(lastBlock, 10)))
+
+	return ls.db.Write(batch, nil)
+}
+
+func (ls *logScanner) lastScannedBlock() (uint64, bool, error) {
+	value, err := ls.db.Get([]byte(selfHealLastBlockKey), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	block, err := strconv.ParseUint(string(value), 10, 64)
+
+	return block, err == nil, err
+}
+
+// lastKey returns the greatest key with the given prefix, if any
+func (ls *logScanner) lastKey(prefix string) (string, error) {
+	iter := ls.db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+
+	if !iter.Last() {
+		return "", iter.Error()
+	}
+
+	return string(iter.Key()), nil
+}
+
+func (ls *logScanner) getLog(key []byte) (*types.Log, error) {
+	value, err := ls.db.Get(key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vLog types.Log
+	if err := json.Unmarshal(value, &vLog); err != nil {
+		return nil, err
+	}
+
+	return &vLog, nil
+}
+
+func stateSyncedKey(stateID uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", selfHealStateSyncedKeyPrefix, stateID))
+}
+
+func stakeUpdatePrefix(validatorID uint64) string {
+	return fmt.Sprintf("%s%020d-", selfHealStakeUpdateKeyPrefix, validatorID)
+}
+
+func stakeUpdateKey(validatorID uint64, nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", stakeUpdatePrefix(validatorID), nonce))
+}
@@ -0,0 +1,143 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	testStateSyncedID = common.HexToHash("0x01")
+	testStakeUpdateID = common.HexToHash("0x02")
+)
+
+// fakeLogFilterer returns the logs within the queried range
+type fakeLogFilterer struct {
+	logs    []types.Log
+	queries [][2]uint64
+}
+
+func (f *fakeLogFilterer) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	f.queries = append(f.queries, [2]uint64{from, to})
+
+	var logs []types.Log
+
+	for _, vLog := range f.logs {
+		if vLog.BlockNumber >= from && vLog.BlockNumber <= to {
+			logs = append(logs, vLog)
+		}
+	}
+
+	return logs, nil
+}
+
+func (f *fakeLogFilterer) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, nil
+}
+
+func topic(n uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(n))
+}
+
+func newTestLogScanner(t *testing.T, filterer *fakeLogFilterer, finalized uint64, startBlock uint64) *logScanner {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	return &logScanner{
+		logger: log.NewNopLogger(),
+		db:     db,
+		client: filterer,
+		finalizedBlock: func() (uint64, error) {
+			return finalized, nil
+		},
+		addresses: func() (common.Address, common.Address, error) {
+			return common.HexToAddress("0x10"), common.HexToAddress("0x20"), nil
+		},
+		stateSyncedID: testStateSyncedID,
+		stakeUpdateID: testStakeUpdateID,
+		scanRange:     10,
+		startBlock:    startBlock,
+	}
+}
+
+func TestLogScannerIndexesEvents(t *testing.T) {
+	t.Parallel()
+
+	filterer := &fakeLogFilterer{
+		logs: []types.Log{
+			{BlockNumber: 101, Index: 1, Topics: []common.Hash{testStateSyncedID, topic(7), topic(0)}},
+			{BlockNumber: 115, Index: 2, Topics: []common.Hash{testStateSyncedID, topic(8), topic(0)}},
+			{BlockNumber: 118, Index: 3, Topics: []common.Hash{testStakeUpdateID, topic(4), topic(2), topic(100)}},
+			{BlockNumber: 119, Index: 4, Topics: []common.Hash{testStakeUpdateID, topic(4), topic(3), topic(200)}},
+			{BlockNumber: 119, Index: 5, Topics: []common.Hash{testStakeUpdateID, topic(4), topic(4), topic(300)}, Removed: true},
+		},
+	}
+
+	ls := newTestLogScanner(t, filterer, 125, 100)
+	ctx := context.Background()
+
+	stateID, err := ls.getLatestStateID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), stateID.Uint64())
+
+	// bounded ranges up to the finalized block
+	require.Equal(t, [][2]uint64{{100, 109}, {110, 119}, {120, 125}}, filterer.queries)
+
+	stateSynced, err := ls.getStateSync(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), stateSynced.BlockNumber)
+	require.Equal(t, uint(1), stateSynced.Index)
+
+	_, err = ls.getStateSync(ctx, 9)
+	require.Error(t, err)
+
+	nonce, err := ls.getLatestNonce(ctx, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nonce)
+
+	nonce, err = ls.getLatestNonce(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), nonce)
+
+	stakeUpdate, err := ls.getStakeUpdate(ctx, 4, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint(3), stakeUpdate.Index)
+
+	// the next scans resume from the last scanned block
+	ls.lastScan = ls.lastScan.Add(-logScanMinInterval)
+	ls.finalizedBlock = func() (uint64, error) { return 130, nil }
+
+	_, err = ls.getLatestStateID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [2]uint64{126, 130}, filterer.queries[len(filterer.queries)-1])
+}
+
+func TestLogScannerStartsAtFinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	filterer := &fakeLogFilterer{}
+	ls := newTestLogScanner(t, filterer, 500, 0)
+
+	stateID, err := ls.getLatestStateID(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), stateID.Uint64())
+	require.Empty(t, filterer.queries)
+
+	lastBlock, found, err := ls.lastScannedBlock()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(500), lastBlock)
+}
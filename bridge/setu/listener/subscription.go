@@ -0,0 +1,223 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// subscribe attempts before polling until the next retry
+	subscribeAttempts = 5
+
+	// wait after the first failed subscribe attempt, doubled after each attempt
+	subscribeRetryWait = time.Second
+
+	// wait before subscribing again once all the attempts failed
+	subscribeRetryInterval = 5 * time.Minute
+)
+
+var (
+	errSubscriptionStalled = errors.New("no new block received before the watchdog timeout")
+	errSubscriptionClosed  = errors.New("subscription closed")
+)
+
+// subscribeFunc subscribes to new blocks. The subscription handles the new
+// blocks itself and notifies each of them on alive, for the watchdog.
+type subscribeFunc func(ctx context.Context, alive chan<- struct{}) (ethereum.Subscription, error)
+
+// superviseSubscription keeps the listener subscribed to new blocks until the
+// context is done. It polls while the subscription is down, and subscribes
+// again when it fails or stalls.
+func (bl *BaseListener) superviseSubscription(ctx context.Context, subscribe subscribeFunc, pollInterval time.Duration, number *big.Int) {
+	watchdogTimeout := helper.GetConfig().ListenerWatchdogTimeout
+
+	for {
+		stopPolling := bl.startFallbackPolling(ctx, pollInterval, number)
+		subscription, alive := bl.subscribeWithBackoff(ctx, subscribe)
+
+		stopPolling()
+
+		if subscription == nil {
+			bl.Logger.Info("Subscription stopped")
+			return
+		}
+
+		bl.Logger.Info("Subscribed to new blocks", "watchdogTimeout", watchdogTimeout)
+		util.SetListenerMode(bl.name, util.ListenerModeSubscribed, watchdogTimeout)
+
+		err := watchSubscription(ctx, subscription, alive, watchdogTimeout)
+		subscription.Unsubscribe()
+
+		if ctx.Err() != nil {
+			bl.Logger.Info("Subscription stopped")
+			return
+		}
+
+		bl.Logger.Error("Subscription to new blocks failed, falling back to polling", "error", err)
+	}
+}
+
+// startFallbackPolling polls for new blocks until the returned function is called
+func (bl *BaseListener) startFallbackPolling(ctx context.Context, pollInterval time.Duration, number *big.Int) func() {
+	pollCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		bl.impl.StartPolling(pollCtx, pollInterval, number)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// subscribeWithBackoff subscribes with an exponential backoff, and tries again
+// after subscribeRetryInterval if all the attempts failed. It returns a nil
+// subscription once the context is done.
+func (bl *BaseListener) subscribeWithBackoff(ctx context.Context, subscribe subscribeFunc) (ethereum.Subscription, <-chan struct{}) {
+	alive := make(chan struct{}, 1)
+
+	for {
+		var subscription ethereum.Subscription
+
+		err := helper.ExponentialBackoff(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			var err error
+			if subscription, err = subscribe(ctx, alive); err != nil {
+				bl.Logger.Debug("Error subscribing to new blocks", "error", err)
+			}
+
+			return err
+		}, subscribeAttempts, subscribeRetryWait)
+
+		if ctx.Err() != nil {
+			if subscription != nil {
+				subscription.Unsubscribe()
+			}
+
+			return nil, nil
+		}
+
+		if err == nil {
+			return subscription, alive
+		}
+
+		bl.Logger.Error("Unable to subscribe to new blocks, polling until the next attempt", "retryIn", subscribeRetryInterval, "error", err)
+
+		select {
+		case <-time.After(subscribeRetryInterval):
+		case <-ctx.Done():
+			return nil, nil
+		}
+	}
+}
+
+// watchSubscription waits until the subscription fails, or doesn't notify a
+// new block within the timeout
+func watchSubscription(ctx context.Context, subscription ethereum.Subscription, alive <-chan struct{}, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-alive:
+			if !timer.Stop() {
+				<-timer.C
+			}
+
+			timer.Reset(timeout)
+		case err := <-subscription.Err():
+			if err == nil {
+				err = errSubscriptionClosed
+			}
+
+			return err
+		case <-timer.C:
+			return errSubscriptionStalled
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// notifyAlive notifies a new block to the watchdog, without waiting for it
+func notifyAlive(alive chan<- struct{}) {
+	select {
+	case alive <- struct{}{}:
+	default:
+	}
+}
+
+// subscribeNewHeads returns a subscription to the new heads of the chain
+// through its websocket endpoint. When number is set, the header of that
+// number is fetched on each new head.
+func (bl *BaseListener) subscribeNewHeads(number *big.Int) subscribeFunc {
+	return func(ctx context.Context, alive chan<- struct{}) (ethereum.Subscription, error) {
+		client, err := ethclient.DialContext(ctx, bl.chainWSURL)
+		if err != nil {
+			return nil, err
+		}
+
+		heads := make(chan *types.Header)
+
+		headSubscription, err := client.SubscribeNewHead(ctx, heads)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer client.Close()
+			defer headSubscription.Unsubscribe()
+
+			var lastPushed time.Time
+
+			for {
+				select {
+				case head := <-heads:
+					notifyAlive(alive)
+
+					// the header process reports the head of the pushed headers
+					if time.Since(lastPushed) < bl.minHeaderInterval {
+						util.SetListenerHead(bl.name, head.Number.Uint64())
+						continue
+					}
+
+					bHeader := &blockHeader{header: head, isFinalized: false}
+
+					if number != nil {
+						fetched, err := bl.fetchHeader(ctx, number)
+						if err != nil {
+							bl.Logger.Error("Error in fetching block header on new head", "err", err)
+						}
+
+						bHeader = fetched
+					}
+
+					if bHeader != nil && bl.pushHeader(ctx, bHeader) {
+						lastPushed = time.Now()
+					}
+				case err := <-headSubscription.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	}
+}
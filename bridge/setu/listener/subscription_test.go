@@ -0,0 +1,119 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const testWatchdogTimeout = 200 * time.Millisecond
+
+// newTestSubscription returns a subscription notifying a new block on alive
+// for each value sent on blocks, it fails with the error sent on fail
+func newTestSubscription(alive chan<- struct{}, blocks <-chan struct{}, fail <-chan error) ethereum.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		for {
+			select {
+			case <-blocks:
+				notifyAlive(alive)
+			case err := <-fail:
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
+
+func TestWatchSubscription(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Stalled", func(t *testing.T) {
+		t.Parallel()
+
+		alive := make(chan struct{}, 1)
+		blocks := make(chan struct{})
+		subscription := newTestSubscription(alive, blocks, nil)
+
+		defer subscription.Unsubscribe()
+
+		// new blocks keep the subscription alive past the timeout
+		go func() {
+			for i := 0; i < 4; i++ {
+				time.Sleep(testWatchdogTimeout / 2)
+				blocks <- struct{}{}
+			}
+		}()
+
+		start := time.Now()
+		err := watchSubscription(context.Background(), subscription, alive, testWatchdogTimeout)
+
+		require.ErrorIs(t, err, errSubscriptionStalled)
+		require.GreaterOrEqual(t, time.Since(start), 2*testWatchdogTimeout)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		t.Parallel()
+
+		alive := make(chan struct{}, 1)
+		fail := make(chan error, 1)
+		subscription := newTestSubscription(alive, nil, fail)
+
+		defer subscription.Unsubscribe()
+
+		errConnection := errors.New("connection lost")
+		fail <- errConnection
+
+		require.ErrorIs(t, watchSubscription(context.Background(), subscription, alive, time.Minute), errConnection)
+	})
+
+	t.Run("Stopped", func(t *testing.T) {
+		t.Parallel()
+
+		alive := make(chan struct{}, 1)
+		subscription := newTestSubscription(alive, nil, nil)
+
+		defer subscription.Unsubscribe()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorIs(t, watchSubscription(ctx, subscription, alive, time.Minute), context.Canceled)
+	})
+}
+
+func TestSubscribeWithBackoff(t *testing.T) {
+	t.Parallel()
+
+	bl := &BaseListener{Logger: log.NewNopLogger()}
+
+	attempts := 0
+	subscribe := func(ctx context.Context, alive chan<- struct{}) (ethereum.Subscription, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errors.New("connection refused")
+		}
+
+		return newTestSubscription(alive, nil, nil), nil
+	}
+
+	subscription, alive := bl.subscribeWithBackoff(context.Background(), subscribe)
+	require.NotNil(t, subscription, "It should subscribe again after a failed attempt")
+	require.NotNil(t, alive)
+	require.Equal(t, 2, attempts)
+
+	subscription.Unsubscribe()
+
+	// no subscription once stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	subscription, _ = bl.subscribeWithBackoff(ctx, subscribe)
+	require.Nil(t, subscription)
+}
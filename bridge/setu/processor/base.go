@@ -1,15 +1,13 @@
 package processor
 
 import (
-	"encoding/base64"
-	"io"
-	"net/http"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -18,6 +16,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/txmanager"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/helper"
@@ -45,6 +44,9 @@ type BaseProcessor struct {
 	// tx broadcaster
 	txBroadcaster *broadcaster.TxBroadcaster
 
+	// main chain tx manager, replacing the stuck submissions
+	txManager *txmanager.TxManager
+
 	// The "subclass" of BaseProcessor
 	impl Processor
 
@@ -100,6 +102,24 @@ func (bp *BaseProcessor) String() string {
 	return bp.name
 }
 
+// registerTask registers the task with the queue, recording its successful
+// runs for the bridge health
+func (bp *BaseProcessor) registerTask(name string, taskFunc interface{}) error {
+	return bp.queueConnector.RegisterTask(name, util.TrackTask(bp.name, name, taskFunc))
+}
+
+// trackMainchainTx hands a submitted main chain tx to the tx manager, which
+// replaces it with bumped fees while it stays pending
+func (bp *BaseProcessor) trackMainchainTx(kind string, tx *ethTypes.Transaction) {
+	if bp.txManager == nil {
+		return
+	}
+
+	if err := bp.txManager.Track(kind, tx); err != nil {
+		bp.Logger.Error("Error while tracking main chain tx", "kind", kind, "txHash", tx.Hash(), "error", err)
+	}
+}
+
 // OnStop stops all necessary go routines
 func (bp *BaseProcessor) Stop() {
 	// override to stop any go-routines in individual processors
@@ -155,31 +175,12 @@ func (bp *BaseProcessor) isOldTx(_ cliContext.CLIContext, txHash string, logInde
 func (bp *BaseProcessor) checkTxAgainstMempool(msg types.Msg, event interface{}) (bool, error) {
 	defer util.LogElapsedTimeForStateSyncedEvent(event, "checkTxAgainstMempool", time.Now())
 
-	endpoint := helper.GetConfig().TendermintRPCUrl + util.TendermintUnconfirmedTxsURL
-
-	resp, err := helper.Client.Get(endpoint)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		bp.Logger.Error("Error fetching mempool tx", "url", endpoint, "error", err)
-		return false, err
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	defer resp.Body.Close()
-
+	txs, _, err := util.GetUnconfirmedTxs()
 	if err != nil {
 		bp.Logger.Error("Error fetching mempool tx", "error", err)
 		return false, err
 	}
 
-	// a minimal response of the unconfirmed txs
-	var response util.TendermintUnconfirmedTxs
-
-	err = jsoniter.ConfigFastest.Unmarshal(body, &response)
-	if err != nil {
-		bp.Logger.Error("Error unmarshalling response received from Heimdall Server", "error", err)
-		return false, err
-	}
-
 	// Iterate over txs present in the mempool
 	// We can verify if the message we're about to send is present by
 	// checking the type of transaction, the transaction hash and log index
@@ -187,56 +188,51 @@ func (bp *BaseProcessor) checkTxAgainstMempool(msg types.Msg, event interface{})
 
 	status := false
 Loop:
-	for _, txn := range response.Result.Txs {
-		// Tendermint encodes the transactions with base64 encoding. Decode it first.
-		txBytes, err := base64.StdEncoding.DecodeString(txn)
-		if err != nil {
-			bp.Logger.Error("Error decoding tx (base64 decoder) while checking against mempool", "error", err)
-			continue
-		}
-
+	for _, txBytes := range txs {
 		// Unmarshal the transaction from bytes
 		decodedTx, err := helper.GetTxDecoder(bp.cliCtx.Codec)(txBytes)
 		if err != nil {
 			bp.Logger.Error("Error decoding tx (tx decoder) while checking against mempool", "error", err)
 			continue
 		}
-		txMsg := decodedTx.GetMsgs()[0]
-
-		// We only need to check for `event-record` type transactions.
-		// If required, add case for others here.
-		switch txMsg.Type() {
-		case "event-record":
-
-			// typecast the txs for clerk type message
-			mempoolTxMsg, ok := txMsg.(clerkTypes.MsgEventRecord)
-			if !ok {
-				bp.Logger.Error("Unable to typecast message to clerk event record while checking against mempool")
-				continue Loop
-			}
-
-			// typecast the msg for clerk type message
-			clerkMsg, ok := msg.(clerkTypes.MsgEventRecord)
-			if !ok {
-				bp.Logger.Error("Unable to typecast message to clerk event record while checking against mempool")
-				continue Loop
-			}
 
-			// check the transaction hash in message
-			if clerkMsg.GetTxHash() != mempoolTxMsg.GetTxHash() {
-				continue Loop
+		// a batch tx carries several msgs, check each of them
+		for _, txMsg := range decodedTx.GetMsgs() {
+			// We only need to check for `event-record` type transactions.
+			// If required, add case for others here.
+			switch txMsg.Type() {
+			case "event-record":
+
+				// typecast the txs for clerk type message
+				mempoolTxMsg, ok := txMsg.(clerkTypes.MsgEventRecord)
+				if !ok {
+					bp.Logger.Error("Unable to typecast message to clerk event record while checking against mempool")
+					continue
+				}
+
+				// typecast the msg for clerk type message
+				clerkMsg, ok := msg.(clerkTypes.MsgEventRecord)
+				if !ok {
+					bp.Logger.Error("Unable to typecast message to clerk event record while checking against mempool")
+					continue Loop
+				}
+
+				// check the transaction hash in message
+				if clerkMsg.GetTxHash() != mempoolTxMsg.GetTxHash() {
+					continue
+				}
+
+				// check the log index in the message
+				if clerkMsg.GetLogIndex() != mempoolTxMsg.GetLogIndex() {
+					continue
+				}
+
+				// If we reach here, there's already a same transaction in the mempool
+				status = true
+				break Loop
+			default:
+				// ignore
 			}
-
-			// check the log index in the message
-			if clerkMsg.GetLogIndex() != mempoolTxMsg.GetLogIndex() {
-				continue Loop
-			}
-
-			// If we reach here, there's already a same transaction in the mempool
-			status = true
-			break Loop
-		default:
-			// ignore
 		}
 	}
 
@@ -6,10 +6,8 @@ import (
 	"errors"
 	"math"
 	"math/big"
-	"strconv"
 	"time"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	jsoniter "github.com/json-iterator/go"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -73,17 +71,21 @@ func (cp *CheckpointProcessor) Start() error {
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
+	if err := cp.registerTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToHeimdall", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
+	if err := cp.registerTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchain", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
+	if err := cp.registerTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
+
+	if err := cp.registerTask(backupCheckpointTask, cp.sendBackupCheckpointToRootchain); err != nil {
+		cp.Logger.Error("RegisterTasks | sendBackupCheckpointToRootchain", "error", err)
+	}
 }
 
 func (cp *CheckpointProcessor) startPollingForNoAck(ctx context.Context, interval time.Duration) {
@@ -181,21 +183,16 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 // 1. check if i am the current proposer.
 // 2. check if this checkpoint has to be submitted to rootchain
 // 3. if so, create and broadcast checkpoint transaction to rootchain
+// 4. if not and i am a backup submitter, schedule a delayed submission
 func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, blockHeight int64) error {
 	cp.Logger.Info("Received sendCheckpointToRootchain request", "eventBytes", eventBytes, "blockHeight", blockHeight)
 
-	var event sdk.StringEvent
-	if err := jsoniter.ConfigFastest.Unmarshal([]byte(eventBytes), &event); err != nil {
+	event, err := parseCheckpointEvent(eventBytes)
+	if err != nil {
 		cp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
 		return err
 	}
 
-	// var tx = sdk.TxResponse{}
-	// if err := jsoniter.Unmarshal([]byte(txBytes), &tx); err != nil {
-	// 	cp.Logger.Error("Error unmarshalling txResponse", "error", err)
-	// 	return err
-	// }
-
 	cp.Logger.Info("processing checkpoint confirmation event", "eventtype", event.Type)
 
 	isCurrentProposer, err := util.IsCurrentProposer(cp.cliCtx)
@@ -204,25 +201,7 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 		return err
 	}
 
-	var (
-		startBlock uint64
-		endBlock   uint64
-		txHash     string
-	)
-
-	for _, attr := range event.Attributes {
-		if attr.Key == checkpointTypes.AttributeKeyStartBlock {
-			startBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
-		}
-
-		if attr.Key == checkpointTypes.AttributeKeyEndBlock {
-			endBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
-		}
-
-		if attr.Key == hmTypes.AttributeKeyTxHash {
-			txHash = attr.Value
-		}
-	}
+	startBlock, endBlock, txHash := event.startBlock, event.endBlock, event.txHash
 
 	checkpointContext, err := cp.getCheckpointContext()
 	if err != nil {
@@ -240,6 +219,12 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 			cp.Logger.Error("Error sending checkpoint to rootchain", "error", err)
 			return err
 		}
+
+		return nil
+	}
+
+	if shouldSend && helper.GetConfig().BackupSubmitters > 0 {
+		return cp.scheduleBackupCheckpoint(eventBytes, blockHeight)
 	}
 
 	cp.Logger.Info("I am not the current proposer or checkpoint already sent. Ignoring", "eventType", event.Type)
@@ -281,6 +266,8 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			"logIndex", uint64(log.Index),
 		)
 
+		cp.recordCheckpointSubmission(checkpointNumber.Uint64(), event, log.TxHash)
+
 		// fetch latest checkpoint
 		latestCheckpoint, err := util.GetLatestCheckpoint(cp.cliCtx)
 		// event checkpoint is older than or equal to latest checkpoint
@@ -544,10 +531,14 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(checkpointCont
 			return err
 		}
 
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, rootChainAddress, rootChainInstance); err != nil {
+		checkpointTx, err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, rootChainAddress, rootChainInstance)
+		if err != nil {
 			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
+
+		cp.trackMainchainTx("checkpoint", checkpointTx)
+		cp.markCheckpointSent(start, end, checkpointTx.Hash())
 	}
 
 	return nil
@@ -695,9 +686,13 @@ func (cp *CheckpointProcessor) shouldSendCheckpoint(checkpointContext *Checkpoin
 	cp.Logger.Info("Validating if checkpoint needs to be pushed", "commitedLastBlock", currentChildBlock, "startBlock", start)
 	// check if we need to send checkpoint or not
 	if ((currentChildBlock + 1) == start) || (currentChildBlock == 0 && start == 0) {
-		cp.Logger.Info("Checkpoint Valid", "startBlock", start)
+		if cp.isCheckpointSent(start, end) {
+			cp.Logger.Info("Checkpoint already submitted, waiting for it to be mined", "startBlock", start, "endBlock", end)
+		} else {
+			cp.Logger.Info("Checkpoint Valid", "startBlock", start)
 
-		shouldSend = true
+			shouldSend = true
+		}
 	} else if currentChildBlock > start {
 		cp.Logger.Info("Start block does not match, checkpoint already sent", "commitedLastBlock", currentChildBlock, "startBlock", start)
 	} else if currentChildBlock > end {
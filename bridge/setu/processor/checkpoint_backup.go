@@ -0,0 +1,289 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// task submitting a checkpoint the proposer didn't submit
+	backupCheckpointTask = "sendBackupCheckpointToRootchain"
+
+	// storage key prefix of the checkpoints submitted by this bridge, followed by their start block
+	checkpointSentKeyPrefix = "checkpoint-sent-"
+	// storage key prefix of the checkpoint submitters, followed by the checkpoint number
+	checkpointSubmissionKeyPrefix = "checkpoint-submission-"
+)
+
+var checkpointSubmissionCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bridge",
+	Subsystem: helper.GetConfig().Chain,
+	Name:      "checkpoint_submissions",
+	Help:      "The total number of checkpoints submitted to rootchain, by submitter",
+}, []string{"submitter", "backup"})
+
+// checkpointEvent is the checkpoint confirmation event from heimdall
+type checkpointEvent struct {
+	sdk.StringEvent
+
+	startBlock uint64
+	endBlock   uint64
+	txHash     string
+}
+
+// checkpointSent is a checkpoint this bridge submitted to rootchain
+type checkpointSent struct {
+	EndBlock uint64      `json:"endBlock"`
+	TxHash   common.Hash `json:"txHash"`
+	SentAt   time.Time   `json:"sentAt"`
+}
+
+// CheckpointSubmission records who submitted a checkpoint to rootchain.
+// Backup is set when the submitter is not the checkpoint proposer.
+type CheckpointSubmission struct {
+	Number    uint64         `json:"number"`
+	Proposer  common.Address `json:"proposer"`
+	Submitter common.Address `json:"submitter"`
+	TxHash    common.Hash    `json:"txHash"`
+	Backup    bool           `json:"backup"`
+}
+
+func parseCheckpointEvent(eventBytes string) (*checkpointEvent, error) {
+	var event checkpointEvent
+	if err := jsoniter.ConfigFastest.Unmarshal([]byte(eventBytes), &event.StringEvent); err != nil {
+		return nil, err
+	}
+
+	for _, attr := range event.Attributes {
+		if attr.Key == checkpointTypes.AttributeKeyStartBlock {
+			event.startBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
+		}
+
+		if attr.Key == checkpointTypes.AttributeKeyEndBlock {
+			event.endBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
+		}
+
+		if attr.Key == hmTypes.AttributeKeyTxHash {
+			event.txHash = attr.Value
+		}
+	}
+
+	return &event, nil
+}
+
+// scheduleBackupCheckpoint schedules the submission of a checkpoint if we are
+// one of the next proposers. The n-th of them waits n times the backup
+// submitter delay, so the proposer and the previous backups submit first.
+func (cp *CheckpointProcessor) scheduleBackupCheckpoint(eventBytes string, blockHeight int64) error {
+	rank, err := util.GetProposerRank(cp.cliCtx, helper.GetConfig().BackupSubmitters)
+	if err != nil {
+		cp.Logger.Error("Error checking backup submitter rank", "error", err)
+		return err
+	}
+
+	if rank == 0 {
+		cp.Logger.Info("I am not a backup submitter for this checkpoint. Ignoring")
+		return nil
+	}
+
+	signature := backupCheckpointSignature(eventBytes, blockHeight, rank, time.Now())
+
+	cp.Logger.Info("Scheduling backup checkpoint submission", "rank", rank, "eta", signature.ETA, "blockHeight", blockHeight)
+
+	return cp.queueConnector.SendTask(signature)
+}
+
+// backupCheckpointSignature returns the backup submission task of the
+// checkpoint, delayed by the rank of the backup submitter
+func backupCheckpointSignature(eventBytes string, blockHeight int64, rank uint64, now time.Time) *tasks.Signature {
+	eta := now.Add(time.Duration(rank) * helper.GetConfig().BackupSubmitterDelay)
+
+	return &tasks.Signature{
+		Name: backupCheckpointTask,
+		Args: []tasks.Arg{
+			{
+				Type:  "string",
+				Value: eventBytes,
+			},
+			{
+				Type:  "int64",
+				Value: blockHeight,
+			},
+		},
+		ETA:        &eta,
+		RetryCount: 3,
+	}
+}
+
+// sendBackupCheckpointToRootchain submits a checkpoint which is still missing
+// on rootchain once the proposer and the previous backups had their turn
+func (cp *CheckpointProcessor) sendBackupCheckpointToRootchain(eventBytes string, blockHeight int64) error {
+	event, err := parseCheckpointEvent(eventBytes)
+	if err != nil {
+		cp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
+		return err
+	}
+
+	checkpointContext, err := cp.getCheckpointContext()
+	if err != nil {
+		return err
+	}
+
+	shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, event.startBlock, event.endBlock)
+	if err != nil {
+		return err
+	}
+
+	if !shouldSend {
+		cp.Logger.Info("Checkpoint already sent, skipping backup submission", "start", event.startBlock, "end", event.endBlock)
+		return nil
+	}
+
+	// the checkpoint tx of the proposer may not be mined yet
+	pending, err := cp.isCheckpointPending(checkpointContext, event.endBlock)
+	if err != nil {
+		return err
+	}
+
+	if pending {
+		cp.Logger.Info("Checkpoint tx pending on rootchain, checking again later", "start", event.startBlock, "end", event.endBlock)
+		return tasks.NewErrRetryTaskLater("checkpoint tx pending on rootchain", helper.GetConfig().BackupSubmitterDelay)
+	}
+
+	cp.Logger.Info("Proposer didn't submit the checkpoint, submitting it as backup", "start", event.startBlock, "end", event.endBlock)
+
+	if err := cp.createAndSendCheckpointToRootchain(checkpointContext, event.startBlock, event.endBlock, blockHeight, common.FromHex(event.txHash)); err != nil {
+		cp.Logger.Error("Error sending backup checkpoint to rootchain", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// isCheckpointPending checks if the checkpoint is in the pending state of
+// rootchain, which includes the txs waiting in the mempool of the node
+func (cp *CheckpointProcessor) isCheckpointPending(checkpointContext *CheckpointContext, end uint64) (bool, error) {
+	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(checkpointContext.ChainmanagerParams.ChainParams.RootChainAddress.EthAddress())
+	if err != nil {
+		cp.Logger.Error("Error while creating rootchain instance", "error", err)
+		return false, err
+	}
+
+	pendingChildBlock, err := rootChainInstance.GetLastChildBlock(&bind.CallOpts{Pending: true})
+	if err != nil {
+		cp.Logger.Error("Error fetching pending child block", "error", err)
+		return false, err
+	}
+
+	return pendingChildBlock.Uint64() >= end, nil
+}
+
+// markCheckpointSent stores the checkpoint submitted by this bridge, so it is
+// not submitted again while the tx is pending
+func (cp *CheckpointProcessor) markCheckpointSent(start uint64, end uint64, txHash common.Hash) {
+	value, err := jsoniter.ConfigFastest.Marshal(checkpointSent{
+		EndBlock: end,
+		TxHash:   txHash,
+		SentAt:   time.Now(),
+	})
+	if err == nil {
+		err = cp.storageClient.Put(checkpointSentKey(start), value, nil)
+	}
+
+	if err != nil {
+		cp.Logger.Error("Error while storing sent checkpoint", "start", start, "error", err)
+	}
+}
+
+// isCheckpointSent checks if this bridge submitted the checkpoint already.
+// The submission is forgotten after the no-ack wait time, when the checkpoint
+// is proposed again.
+func (cp *CheckpointProcessor) isCheckpointSent(start uint64, end uint64) bool {
+	value, err := cp.storageClient.Get(checkpointSentKey(start), nil)
+	if err != nil {
+		return false
+	}
+
+	var sent checkpointSent
+	if err := jsoniter.ConfigFastest.Unmarshal(value, &sent); err != nil {
+		return false
+	}
+
+	return sent.EndBlock == end && time.Since(sent.SentAt) < helper.GetConfig().NoACKWaitTime
+}
+
+// recordCheckpointSubmission records the sender of the rootchain tx of a
+// checkpoint next to its proposer
+func (cp *CheckpointProcessor) recordCheckpointSubmission(number uint64, event *rootchain.RootchainNewHeaderBlock, txHash common.Hash) {
+	if err := cp.storageClient.Delete(checkpointSentKey(event.Start.Uint64()), nil); err != nil {
+		cp.Logger.Error("Error while deleting sent checkpoint", "start", event.Start, "error", err)
+	}
+
+	// the ack task may be retried
+	if recorded, _ := cp.storageClient.Has(checkpointSubmissionKey(number), nil); recorded {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cp.contractConnector.MainChainTimeout)
+	defer cancel()
+
+	tx, _, err := cp.contractConnector.MainChainClient.TransactionByHash(ctx, txHash)
+	if err != nil {
+		cp.Logger.Error("Error fetching checkpoint tx from rootchain", "txHash", txHash, "error", err)
+		return
+	}
+
+	submitter, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		cp.Logger.Error("Error recovering checkpoint tx sender", "txHash", txHash, "error", err)
+		return
+	}
+
+	submission := CheckpointSubmission{
+		Number:    number,
+		Proposer:  event.Proposer,
+		Submitter: submitter,
+		TxHash:    txHash,
+		Backup:    submitter != event.Proposer,
+	}
+
+	value, err := jsoniter.ConfigFastest.Marshal(submission)
+	if err != nil {
+		cp.Logger.Error("Error marshalling checkpoint submission", "error", err)
+		return
+	}
+
+	if err := cp.storageClient.Put(checkpointSubmissionKey(number), value, nil); err != nil {
+		cp.Logger.Error("Error while storing checkpoint submission", "number", number, "error", err)
+		return
+	}
+
+	checkpointSubmissionCounter.WithLabelValues(submitter.Hex(), strconv.FormatBool(submission.Backup)).Inc()
+
+	cp.Logger.Info("Recorded checkpoint submission", "number", number, "proposer", submission.Proposer.Hex(), "submitter", submitter.Hex(), "backup", submission.Backup)
+}
+
+func checkpointSentKey(start uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", checkpointSentKeyPrefix, start))
+}
+
+func checkpointSubmissionKey(number uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", checkpointSubmissionKeyPrefix, number))
+}
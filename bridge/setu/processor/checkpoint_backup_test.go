@@ -0,0 +1,181 @@
+package processor
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// testEthAPI serves the rootchain txs of the tests
+type testEthAPI struct {
+	tx    *types.Transaction
+	calls int32
+}
+
+func (api *testEthAPI) GetTransactionByHash(hash common.Hash) (json.RawMessage, error) {
+	atomic.AddInt32(&api.calls, 1)
+
+	if hash != api.tx.Hash() {
+		return nil, nil
+	}
+
+	return api.tx.MarshalJSON()
+}
+
+func newTestCheckpointProcessor(t *testing.T) *CheckpointProcessor {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	cp := &CheckpointProcessor{}
+	cp.Logger = log.NewNopLogger()
+	cp.storageClient = db
+
+	return cp
+}
+
+func TestParseCheckpointEvent(t *testing.T) {
+	t.Parallel()
+
+	eventBytes, err := jsoniter.ConfigFastest.Marshal(sdk.StringEvent{
+		Type: checkpointTypes.EventTypeCheckpoint,
+		Attributes: []sdk.Attribute{
+			sdk.NewAttribute(checkpointTypes.AttributeKeyStartBlock, "256"),
+			sdk.NewAttribute(checkpointTypes.AttributeKeyEndBlock, "511"),
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, "0x01"),
+		},
+	})
+	require.NoError(t, err)
+
+	event, err := parseCheckpointEvent(string(eventBytes))
+	require.NoError(t, err)
+	require.Equal(t, checkpointTypes.EventTypeCheckpoint, event.Type)
+	require.Equal(t, uint64(256), event.startBlock)
+	require.Equal(t, uint64(511), event.endBlock)
+	require.Equal(t, "0x01", event.txHash)
+
+	_, err = parseCheckpointEvent("{")
+	require.Error(t, err)
+}
+
+func TestBackupCheckpointSignature(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	delay := helper.GetConfig().BackupSubmitterDelay
+	now := time.Now()
+
+	first := backupCheckpointSignature("event", 10, 1, now)
+	require.Equal(t, backupCheckpointTask, first.Name)
+	require.Equal(t, "event", first.Args[0].Value)
+	require.Equal(t, int64(10), first.Args[1].Value)
+	require.Equal(t, now.Add(delay), *first.ETA, "First backup waits one delay")
+
+	second := backupCheckpointSignature("event", 10, 2, now)
+	require.Equal(t, now.Add(2*delay), *second.ETA, "Each backup waits for the ones ranked before it")
+}
+
+func TestIsCheckpointSent(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	cp := newTestCheckpointProcessor(t)
+
+	require.False(t, cp.isCheckpointSent(256, 511), "Checkpoint isn't sent yet")
+
+	cp.markCheckpointSent(256, 511, common.HexToHash("0x01"))
+	require.True(t, cp.isCheckpointSent(256, 511))
+	require.False(t, cp.isCheckpointSent(256, 600), "A checkpoint with another end block isn't sent")
+	require.False(t, cp.isCheckpointSent(512, 767), "A checkpoint with another start block isn't sent")
+
+	// the checkpoint is proposed again after the no-ack wait time
+	value, err := jsoniter.ConfigFastest.Marshal(checkpointSent{
+		EndBlock: 511,
+		TxHash:   common.HexToHash("0x01"),
+		SentAt:   time.Now().Add(-helper.GetConfig().NoACKWaitTime),
+	})
+	require.NoError(t, err)
+	require.NoError(t, cp.storageClient.Put(checkpointSentKey(256), value, nil))
+	require.False(t, cp.isCheckpointSent(256, 511), "Submission is forgotten after the no-ack wait time")
+}
+
+func TestRecordCheckpointSubmission(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signer := types.LatestSignerForChainID(big.NewInt(5))
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		To:       &common.Address{},
+		Value:    big.NewInt(0),
+	}), signer, key)
+	require.NoError(t, err)
+
+	api := &testEthAPI{tx: tx}
+
+	server := rpc.NewServer("test", 0, 0)
+	require.NoError(t, server.RegisterName("eth", api))
+
+	t.Cleanup(server.Stop)
+
+	cp := newTestCheckpointProcessor(t)
+	cp.contractConnector.MainChainClient = ethclient.NewClient(rpc.DialInProc(server))
+	cp.contractConnector.MainChainTimeout = 5 * time.Second
+
+	submitter := crypto.PubkeyToAddress(key.PublicKey)
+	proposer := common.HexToAddress("0x01")
+
+	cp.markCheckpointSent(256, 511, tx.Hash())
+
+	event := &rootchain.RootchainNewHeaderBlock{
+		Proposer: proposer,
+		Start:    big.NewInt(256),
+		End:      big.NewInt(511),
+	}
+	cp.recordCheckpointSubmission(3, event, tx.Hash())
+
+	has, err := cp.storageClient.Has(checkpointSentKey(256), nil)
+	require.NoError(t, err)
+	require.False(t, has, "Sent checkpoint is cleared once acked")
+
+	value, err := cp.storageClient.Get(checkpointSubmissionKey(3), nil)
+	require.NoError(t, err)
+
+	var submission CheckpointSubmission
+	require.NoError(t, jsoniter.ConfigFastest.Unmarshal(value, &submission))
+	require.Equal(t, CheckpointSubmission{
+		Number:    3,
+		Proposer:  proposer,
+		Submitter: submitter,
+		TxHash:    tx.Hash(),
+		Backup:    true,
+	}, submission)
+
+	// a retried ack task doesn't record the submission again
+	cp.recordCheckpointSubmission(3, event, tx.Hash())
+	require.Equal(t, int32(1), atomic.LoadInt32(&api.calls))
+}
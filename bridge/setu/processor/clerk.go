@@ -50,7 +50,7 @@ func (cp *ClerkProcessor) Start() error {
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering clerk tasks")
 
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
+	if err := cp.registerTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendStateSyncedToHeimdall", "error", err)
 	}
 }
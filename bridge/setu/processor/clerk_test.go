@@ -598,7 +598,7 @@ func prepareRootChainListener() (*listener.RootChainListener, func(), error) {
 	}
 
 	rcl.BaseListener = *listener.NewBaseListener(
-		cdc, &queue.QueueConnector{Server: server}, nil, helper.GetMainClient(), "rootchain", rcl)
+		cdc, &queue.QueueConnector{Server: server}, nil, helper.GetMainClient(), "", "rootchain", rcl)
 
 	stopFn = func() {
 		rcl.Stop()
@@ -37,7 +37,7 @@ func (fp *FeeProcessor) Start() error {
 func (fp *FeeProcessor) RegisterTasks() {
 	fp.Logger.Info("Registering fee related tasks")
 
-	if err := fp.queueConnector.Server.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
+	if err := fp.registerTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
 		fp.Logger.Error("RegisterTasks | sendTopUpFeeToHeimdall", "error", err)
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/txmanager"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -24,6 +25,9 @@ type ProcessorService struct {
 	// queue connector
 	queueConnector *queue.QueueConnector
 
+	// main chain tx manager
+	txManager *txmanager.TxManager
+
 	processors []Processor
 }
 
@@ -38,6 +42,7 @@ func NewProcessorService(
 	// creating processor object
 	processorService := &ProcessorService{
 		queueConnector: queueConnector,
+		txManager:      txmanager.NewTxManager(),
 	}
 
 	contractCaller, err := helper.NewContractCaller()
@@ -54,6 +59,7 @@ func NewProcessorService(
 	// initialize checkpoint processor
 	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI)
 	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "checkpoint", checkpointProcessor)
+	checkpointProcessor.txManager = processorService.txManager
 
 	// initialize checkpoint processor
 	milestoneProcessor := &MilestoneProcessor{}
@@ -78,6 +84,7 @@ func NewProcessorService(
 	// initialize slashing processor
 	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
 	slashingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "slashing", slashingProcessor)
+	slashingProcessor.txManager = processorService.txManager
 
 	//
 	// Select processors
@@ -131,6 +138,11 @@ func (processorService *ProcessorService) OnStart() error {
 		processorService.Logger.Error("OnStart | OnStart", "Error", err)
 	} // Always call the overridden method.
 
+	// watch the pending main chain txs, including the ones sent before a restart
+	if err := processorService.txManager.Start(); err != nil {
+		processorService.Logger.Error("OnStart | txManager.Start", "Error", err)
+	}
+
 	// start processors
 	for _, processor := range processorService.processors {
 		processor.RegisterTasks()
@@ -155,5 +167,9 @@ func (processorService *ProcessorService) OnStop() {
 		processor.Stop()
 	}
 
+	if err := processorService.txManager.Stop(); err != nil {
+		processorService.Logger.Error("OnStop | txManager.Stop", "Error", err)
+	}
+
 	processorService.Logger.Info("all processors stopped")
 }
@@ -50,19 +50,19 @@ func (sp *SlashingProcessor) Start() error {
 func (sp *SlashingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering slashing related tasks")
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickToHeimdall", sp.sendTickToHeimdall); err != nil {
+	if err := sp.registerTask("sendTickToHeimdall", sp.sendTickToHeimdall); err != nil {
 		sp.Logger.Error("Failed to register sendTickToHeimdall task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickToRootchain", sp.sendTickToRootchain); err != nil {
+	if err := sp.registerTask("sendTickToRootchain", sp.sendTickToRootchain); err != nil {
 		sp.Logger.Error("Failed to register sendTickToRootchain task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall); err != nil {
+	if err := sp.registerTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall); err != nil {
 		sp.Logger.Error("Failed to register sendTickAckToHeimdall task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall); err != nil {
+	if err := sp.registerTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall); err != nil {
 		sp.Logger.Error("Failed to register sendUnjailToHeimdall task", "error", err)
 	}
 }
@@ -352,11 +352,14 @@ func (sp *SlashingProcessor) createAndSendTickToRootchain(height int64, txHash [
 	}
 
 	// TODO pass sigs in proper form in `SendTick` for slashing
-	if err := sp.contractConnector.SendTick(sideTxData, nil, slashManagerAddress, slashManagerInstance); err != nil {
+	tickTx, err := sp.contractConnector.SendTick(sideTxData, nil, slashManagerAddress, slashManagerInstance)
+	if err != nil {
 		sp.Logger.Info("Error submitting tick to slashManager contract", "error", err)
 		return err
 	}
 
+	sp.trackMainchainTx("tick", tickTx)
+
 	return nil
 }
 
@@ -87,6 +87,8 @@ func (sp *SpanProcessor) checkAndPropose() {
 		return
 	}
 
+	util.RecordTaskSuccess(sp.name, "checkAndPropose")
+
 	// check if current user is among next span producers
 	if sp.isSpanProposer(nextSpanMsg.SelectedProducers) {
 		go sp.propose(lastSpan, nextSpanMsg)
@@ -46,19 +46,19 @@ func (sp *StakingProcessor) Start() error {
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering staking related tasks")
 
-	if err := sp.queueConnector.Server.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
+	if err := sp.registerTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendValidatorJoinToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
+	if err := sp.registerTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendUnstakeInitToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
+	if err := sp.registerTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakeUpdateToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
+	if err := sp.registerTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSignerChangeToHeimdall", "error", err)
 	}
 }
@@ -1,26 +1,46 @@
 package queue
 
 import (
+	"encoding/json"
+
 	"github.com/streadway/amqp"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/RichardKnop/machinery/v1"
 	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 )
 
+// QueueConnector sends tasks to and runs them from either AMQP through
+// machinery, or the bridge db
 type QueueConnector struct {
 	logger log.Logger
 	Server *machinery.Server
+
+	// amqp url, used to inspect the queue
+	dialer string
+
+	// broker is set when the tasks are stored in the bridge db
+	broker *levelDBBroker
+
+	// deadLetters keeps the tasks which ran out of retries
+	deadLetters *DeadLetterStore
 }
 
 const (
 	// machinery task queue
 	QueueName = "machinery_tasks"
+
+	// number of tasks processed concurrently
+	workerConcurrency = 10
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
+// NewQueueConnector returns a queue connector using AMQP. Tasks which run out
+// of retries are kept in the given bridge db, if any.
+func NewQueueConnector(dialer string, db *leveldb.DB) *QueueConnector {
 	// amqp dialer
 	_, err := amqp.Dial(dialer)
 	if err != nil {
@@ -47,15 +67,91 @@ func NewQueueConnector(dialer string) *QueueConnector {
 	connector := QueueConnector{
 		logger: util.Logger().With("module", "QueueConnector"),
 		Server: server,
+		dialer: dialer,
+	}
+
+	// store failed tasks through a machinery error callback
+	if db != nil {
+		connector.deadLetters = NewDeadLetterStore(db)
+
+		if err := server.RegisterTask(deadLetterTaskName, connector.deadLetters.storeDeadLetter); err != nil {
+			panic(err)
+		}
 	}
 
 	// connector
 	return &connector
 }
 
+// NewLevelDBQueueConnector returns a queue connector storing the tasks in the
+// bridge db, without any external broker
+func NewLevelDBQueueConnector(db *leveldb.DB) *QueueConnector {
+	if db == nil {
+		panic("bridge db is not available")
+	}
+
+	logger := util.Logger().With("module", "QueueConnector")
+
+	broker := newLevelDBBroker(db, workerConcurrency, logger)
+
+	return &QueueConnector{
+		logger:      logger,
+		broker:      broker,
+		deadLetters: broker.deadLetters,
+	}
+}
+
+// RegisterTask - registers the function run for the tasks with the given name
+func (qc *QueueConnector) RegisterTask(name string, taskFunc interface{}) error {
+	if qc.broker != nil {
+		return qc.broker.registerTask(name, taskFunc)
+	}
+
+	return qc.Server.RegisterTask(name, taskFunc)
+}
+
+// SendTask - queues the task, it is processed once its ETA is reached
+func (qc *QueueConnector) SendTask(signature *tasks.Signature) error {
+	setTaskUUID(signature)
+	setSentRetryCount(signature)
+
+	if qc.broker != nil {
+		return qc.broker.sendTask(signature)
+	}
+
+	if qc.deadLetters != nil {
+		// the callback gets the task as sent, with the event payload
+		signatureJSON, err := json.Marshal(signature)
+		if err != nil {
+			return err
+		}
+
+		signature.OnError = append(signature.OnError, &tasks.Signature{
+			Name: deadLetterTaskName,
+			Args: []tasks.Arg{
+				{
+					Type:  "string",
+					Value: string(signatureJSON),
+				},
+			},
+		})
+	}
+
+	_, err := qc.Server.SendTask(signature)
+
+	return err
+}
+
 // StartWorker - starts worker to process registered tasks
 func (qc *QueueConnector) StartWorker() {
-	worker := qc.Server.NewWorker("invoke-processor", 10)
+	if qc.broker != nil {
+		qc.logger.Info("Starting bridge db worker")
+		qc.broker.start()
+
+		return
+	}
+
+	worker := qc.Server.NewWorker("invoke-processor", workerConcurrency)
 
 	qc.logger.Info("Starting machinery worker")
 
@@ -63,3 +159,38 @@ func (qc *QueueConnector) StartWorker() {
 
 	worker.LaunchAsync(errors)
 }
+
+// StopWorker - stops the bridge db worker once the running tasks are done, so
+// the bridge db can be closed. The machinery worker runs until the process exits.
+func (qc *QueueConnector) StopWorker() {
+	if qc.broker != nil {
+		qc.logger.Info("Stopping bridge db worker")
+		qc.broker.stop()
+	}
+}
+
+// QueueDepth returns the number of tasks waiting in the queue
+func (qc *QueueConnector) QueueDepth() (int, error) {
+	if qc.broker != nil {
+		return qc.broker.depth()
+	}
+
+	conn, err := amqp.Dial(qc.dialer)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	channel, err := conn.Channel()
+	if err != nil {
+		return 0, err
+	}
+	defer channel.Close()
+
+	queue, err := channel.QueueInspect(QueueName)
+	if err != nil {
+		return 0, err
+	}
+
+	return queue.Messages, nil
+}
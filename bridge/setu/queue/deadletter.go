@@ -0,0 +1,203 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// deadLetterKeyPrefix is the bridge db prefix of tasks which ran out of retries
+	deadLetterKeyPrefix = "queue-dead-letter-"
+
+	// deadLetterTaskName is the machinery error callback storing dead letters
+	deadLetterTaskName = "storeDeadLetter"
+
+	// retryCountHeader keeps the retry count a task was sent with, so it can
+	// be reported and restored on replay
+	retryCountHeader = "bridge_retry_count"
+)
+
+// ErrDeadLetterNotFound is returned when there is no dead letter for a task
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is a task which failed on every attempt
+type DeadLetter struct {
+	UUID      string           `json:"uuid"`
+	TaskName  string           `json:"task_name"`
+	Reason    string           `json:"reason"`
+	Attempts  int              `json:"attempts"`
+	FailedAt  time.Time        `json:"failed_at"`
+	Signature *tasks.Signature `json:"signature"`
+}
+
+// DeadLetterStore keeps dead letters in the bridge db
+type DeadLetterStore struct {
+	db *leveldb.DB
+}
+
+// NewDeadLetterStore returns a dead letter store on the bridge db
+func NewDeadLetterStore(db *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{db: db}
+}
+
+// Add stores a dead letter for the failed task
+func (s *DeadLetterStore) Add(signature *tasks.Signature, reason string) error {
+	deadLetter := DeadLetter{
+		UUID:      signature.UUID,
+		TaskName:  signature.Name,
+		Reason:    reason,
+		Attempts:  sentRetryCount(signature) + 1,
+		FailedAt:  time.Now().UTC(),
+		Signature: signature,
+	}
+
+	value, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(deadLetterKey(signature.UUID), value, nil)
+}
+
+// List returns all dead letters, oldest failure first
+func (s *DeadLetterStore) List() ([]DeadLetter, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(deadLetterKeyPrefix)), nil)
+	defer iter.Release()
+
+	var deadLetters []DeadLetter
+
+	for iter.Next() {
+		var deadLetter DeadLetter
+		if err := decodeDeadLetter(iter.Value(), &deadLetter); err != nil {
+			return nil, fmt.Errorf("invalid dead letter %q: %w", iter.Key(), err)
+		}
+
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(deadLetters, func(i, j int) bool {
+		return deadLetters[i].FailedAt.Before(deadLetters[j].FailedAt)
+	})
+
+	return deadLetters, nil
+}
+
+// Get returns the dead letter of the task with the given uuid
+func (s *DeadLetterStore) Get(uuid string) (*DeadLetter, error) {
+	value, err := s.db.Get(deadLetterKey(uuid), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var deadLetter DeadLetter
+	if err := decodeDeadLetter(value, &deadLetter); err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+// Delete removes the dead letter of the task with the given uuid
+func (s *DeadLetterStore) Delete(uuid string) error {
+	if has, err := s.db.Has(deadLetterKey(uuid), nil); err != nil {
+		return err
+	} else if !has {
+		return ErrDeadLetterNotFound
+	}
+
+	return s.db.Delete(deadLetterKey(uuid), nil)
+}
+
+// Replay sends the task of the dead letter again with its original retry
+// count, and removes the dead letter
+func (s *DeadLetterStore) Replay(uuid string, qc *QueueConnector) error {
+	deadLetter, err := s.Get(uuid)
+	if err != nil {
+		return err
+	}
+
+	signature := deadLetter.Signature
+	signature.UUID = ""
+	signature.ETA = nil
+	signature.RetryCount = sentRetryCount(signature)
+	signature.RetryTimeout = 0
+	signature.OnError = nil
+
+	if err := qc.SendTask(signature); err != nil {
+		return err
+	}
+
+	return s.Delete(uuid)
+}
+
+// storeDeadLetter is the machinery error callback, called with the error of
+// the last attempt and the task as it was sent
+func (s *DeadLetterStore) storeDeadLetter(reason string, signatureJSON string) error {
+	var signature tasks.Signature
+	if err := decodeSignature([]byte(signatureJSON), &signature); err != nil {
+		return err
+	}
+
+	return s.Add(&signature, reason)
+}
+
+// setSentRetryCount records the retry count the task is sent with
+func setSentRetryCount(signature *tasks.Signature) {
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+
+	if _, ok := signature.Headers[retryCountHeader]; !ok {
+		signature.Headers[retryCountHeader] = signature.RetryCount
+	}
+}
+
+// sentRetryCount returns the retry count the task was sent with
+func sentRetryCount(signature *tasks.Signature) int {
+	value, ok := signature.Headers[retryCountHeader]
+	if !ok {
+		return signature.RetryCount
+	}
+
+	retryCount, err := strconv.Atoi(fmt.Sprint(value))
+	if err != nil {
+		return signature.RetryCount
+	}
+
+	return retryCount
+}
+
+func deadLetterKey(uuid string) []byte {
+	return []byte(deadLetterKeyPrefix + uuid)
+}
+
+// decodeDeadLetter decodes a dead letter, keeping the task arguments as json.Number
+func decodeDeadLetter(value []byte, deadLetter *DeadLetter) error {
+	var raw struct {
+		DeadLetter
+		Signature json.RawMessage `json:"signature"`
+	}
+
+	if err := json.Unmarshal(value, &raw); err != nil {
+		return err
+	}
+
+	*deadLetter = raw.DeadLetter
+	deadLetter.Signature = &tasks.Signature{}
+
+	return decodeSignature(raw.Signature, deadLetter.Signature)
+}
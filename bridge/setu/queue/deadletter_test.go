@@ -0,0 +1,93 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openTestDB(t)
+	defer db.Close()
+
+	store := NewDeadLetterStore(db)
+
+	first := stringTask("sendStateSyncedToHeimdall", "payload")
+	first.UUID = "task_1"
+	first.RetryCount = 3
+	setSentRetryCount(first)
+	first.RetryCount = 0
+
+	second := stringTask("sendCheckpointToRootchain", "")
+	second.UUID = "task_2"
+
+	require.NoError(t, store.Add(first, "tx failed"))
+	require.NoError(t, store.Add(second, "no retries"))
+
+	deadLetters, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, "task_1", deadLetters[0].UUID)
+	require.Equal(t, "task_2", deadLetters[1].UUID)
+
+	deadLetter, err := store.Get("task_1")
+	require.NoError(t, err)
+	require.Equal(t, "sendStateSyncedToHeimdall", deadLetter.TaskName)
+	require.Equal(t, "tx failed", deadLetter.Reason)
+	require.Equal(t, 4, deadLetter.Attempts)
+	require.Equal(t, "payload", deadLetter.Signature.Args[0].Value)
+
+	require.NoError(t, store.Delete("task_2"))
+	require.ErrorIs(t, store.Delete("task_2"), ErrDeadLetterNotFound)
+
+	_, err = store.Get("task_2")
+	require.ErrorIs(t, err, ErrDeadLetterNotFound)
+}
+
+func TestDeadLetterStoreReplay(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openTestDB(t)
+	defer db.Close()
+
+	store := NewDeadLetterStore(db)
+
+	signature := stringTask("sendStateSyncedToHeimdall", "payload")
+	signature.UUID = "task_1"
+	signature.RetryCount = 3
+	setSentRetryCount(signature)
+	signature.RetryCount = 0
+	signature.RetryTimeout = 5
+
+	require.NoError(t, store.Add(signature, "tx failed"))
+
+	qc := &QueueConnector{broker: newTestBroker(t, db), deadLetters: store}
+	require.NoError(t, store.Replay("task_1", qc))
+
+	_, err := store.Get("task_1")
+	require.ErrorIs(t, err, ErrDeadLetterNotFound)
+	require.Equal(t, 1, countTasks(t, db))
+
+	// the task is queued again with its original retry budget
+	due, _ := qc.broker.dueTasks(time.Now().Add(time.Hour))
+	require.Len(t, due, 0, "task is not registered yet")
+
+	require.NoError(t, qc.RegisterTask("sendStateSyncedToHeimdall", func(string) error { return nil }))
+
+	due, _ = qc.broker.dueTasks(time.Now().Add(time.Hour))
+	require.Len(t, due, 1)
+
+	value, err := db.Get(due[0], nil)
+	require.NoError(t, err)
+
+	var replayed tasks.Signature
+	require.NoError(t, decodeSignature(value, &replayed))
+	require.NotEqual(t, "task_1", replayed.UUID)
+	require.Equal(t, 3, replayed.RetryCount)
+	require.Equal(t, 0, replayed.RetryTimeout)
+	require.Equal(t, "payload", replayed.Args[0].Value)
+}
@@ -0,0 +1,364 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/retry"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/google/uuid"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// taskKeyPrefix is the bridge db prefix of pending tasks. Keys are ordered
+	// by ETA: <prefix><eta unix nano, zero padded>-<task uuid>
+	taskKeyPrefix = "queue-task-"
+
+	// maxDispatchWait caps the time the dispatcher sleeps between scans
+	maxDispatchWait = time.Minute
+)
+
+// levelDBBroker is an at-least-once task queue persisted in the bridge db.
+// A task is only removed from the db once it succeeded or was moved to the
+// dead letters, so tasks in progress during a restart are run again.
+type levelDBBroker struct {
+	db          *leveldb.DB
+	deadLetters *DeadLetterStore
+	logger      log.Logger
+	concurrency int
+
+	mu              sync.Mutex
+	registeredTasks map[string]interface{}
+	inFlight        map[string]struct{}
+
+	wakeup chan struct{}
+	quit   chan struct{}
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newLevelDBBroker(db *leveldb.DB, concurrency int, logger log.Logger) *levelDBBroker {
+	return &levelDBBroker{
+		db:              db,
+		deadLetters:     NewDeadLetterStore(db),
+		logger:          logger,
+		concurrency:     concurrency,
+		registeredTasks: make(map[string]interface{}),
+		inFlight:        make(map[string]struct{}),
+		wakeup:          make(chan struct{}, 1),
+		quit:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+}
+
+// registerTask registers the function run for tasks with the given name
+func (b *levelDBBroker) registerTask(name string, taskFunc interface{}) error {
+	if err := tasks.ValidateTask(taskFunc); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.registeredTasks[name] = taskFunc
+	b.mu.Unlock()
+
+	b.notify()
+
+	return nil
+}
+
+// sendTask persists the task, it is run once its ETA is reached
+func (b *levelDBBroker) sendTask(signature *tasks.Signature) error {
+	setTaskUUID(signature)
+
+	eta := time.Now()
+	if signature.ETA != nil {
+		eta = *signature.ETA
+	}
+
+	value, err := json.Marshal(signature)
+	if err != nil {
+		return err
+	}
+
+	if err := b.db.Put(taskKey(eta, signature.UUID), value, nil); err != nil {
+		return err
+	}
+
+	b.notify()
+
+	return nil
+}
+
+// start runs the dispatcher until stop is called
+func (b *levelDBBroker) start() {
+	go b.dispatch()
+}
+
+// stop stops dispatching new tasks and waits for the running ones
+func (b *levelDBBroker) stop() {
+	close(b.quit)
+	<-b.done
+	b.wg.Wait()
+}
+
+func (b *levelDBBroker) notify() {
+	select {
+	case b.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (b *levelDBBroker) dispatch() {
+	defer close(b.done)
+
+	for {
+		due, wait := b.dueTasks(time.Now())
+
+		for _, key := range due {
+			b.wg.Add(1)
+
+			go func(key []byte) {
+				defer func() {
+					b.mu.Lock()
+					delete(b.inFlight, string(key))
+					b.mu.Unlock()
+
+					b.wg.Done()
+					b.notify()
+				}()
+
+				b.process(key)
+			}(key)
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-b.wakeup:
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// dueTasks marks the registered tasks with a reached ETA as in flight, up to
+// the worker concurrency, and returns their keys along with the time to wait
+// for the next task
+func (b *levelDBBroker) dueTasks(now time.Time) ([][]byte, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var due [][]byte
+
+	wait := maxDispatchWait
+
+	iter := b.db.NewIterator(util.BytesPrefix([]byte(taskKeyPrefix)), nil)
+	defer iter.Release()
+
+	for len(b.inFlight) < b.concurrency && iter.Next() {
+		key := iter.Key()
+
+		eta, err := taskETA(key)
+		if err != nil {
+			b.logger.Error("Invalid task key", "key", string(key), "error", err)
+			continue
+		}
+
+		if eta.After(now) {
+			// keys are ordered by ETA, the rest is not due yet
+			if d := eta.Sub(now); d < wait {
+				wait = d
+			}
+
+			break
+		}
+
+		if _, ok := b.inFlight[string(key)]; ok {
+			continue
+		}
+
+		var signature tasks.Signature
+		if err := decodeSignature(iter.Value(), &signature); err != nil {
+			b.logger.Error("Invalid task, dropping it", "key", string(key), "error", err)
+
+			if err := b.db.Delete(key, nil); err != nil {
+				b.logger.Error("Error while deleting task", "key", string(key), "error", err)
+			}
+
+			continue
+		}
+
+		// tasks stay queued until their processor registers them
+		if _, ok := b.registeredTasks[signature.Name]; !ok {
+			continue
+		}
+
+		keyCopy := append([]byte{}, key...)
+		b.inFlight[string(keyCopy)] = struct{}{}
+		due = append(due, keyCopy)
+	}
+
+	if err := iter.Error(); err != nil {
+		b.logger.Error("Error while iterating tasks", "error", err)
+	}
+
+	return due, wait
+}
+
+// process runs the task and removes it from the queue, or requeues it if
+// it failed and has retries left
+func (b *levelDBBroker) process(key []byte) {
+	value, err := b.db.Get(key, nil)
+	if err != nil {
+		b.logger.Error("Error while reading task", "key", string(key), "error", err)
+		return
+	}
+
+	var signature tasks.Signature
+	if err := decodeSignature(value, &signature); err != nil {
+		b.logger.Error("Invalid task, dropping it", "key", string(key), "error", err)
+		b.deleteTask(key)
+
+		return
+	}
+
+	b.mu.Lock()
+	taskFunc := b.registeredTasks[signature.Name]
+	b.mu.Unlock()
+
+	task, err := tasks.NewWithSignature(taskFunc, &signature)
+	if err != nil {
+		b.logger.Error("Invalid task arguments, dropping it", "taskName", signature.Name, "uuid", signature.UUID, "error", err)
+		b.deleteTask(key)
+
+		return
+	}
+
+	b.logger.Debug("Processing task", "taskName", signature.Name, "uuid", signature.UUID)
+
+	if _, err = task.Call(); err == nil {
+		b.deleteTask(key)
+		return
+	}
+
+	var retryLater tasks.ErrRetryTaskLater
+
+	switch {
+	case errors.As(err, &retryLater):
+		b.logger.Info("Task asked to be retried later", "taskName", signature.Name, "uuid", signature.UUID, "retryIn", retryLater.RetryIn())
+		b.requeueTask(key, &signature, time.Now().Add(retryLater.RetryIn()))
+	case signature.RetryCount > 0:
+		// same backoff as the machinery worker
+		signature.RetryCount--
+		signature.RetryTimeout = retry.FibonacciNext(signature.RetryTimeout)
+
+		b.logger.Info("Task failed, retrying", "taskName", signature.Name, "uuid", signature.UUID, "retryIn", signature.RetryTimeout, "retriesLeft", signature.RetryCount, "error", err)
+		b.requeueTask(key, &signature, time.Now().Add(time.Duration(signature.RetryTimeout)*time.Second))
+	default:
+		b.logger.Error("Task failed, no retries left, moving it to dead letters", "taskName", signature.Name, "uuid", signature.UUID, "error", err)
+
+		if err := b.deadLetters.Add(&signature, err.Error()); err != nil {
+			// keep the task queued rather than losing it
+			b.logger.Error("Error while storing dead letter", "uuid", signature.UUID, "error", err)
+			return
+		}
+
+		b.deleteTask(key)
+	}
+}
+
+func (b *levelDBBroker) deleteTask(key []byte) {
+	if err := b.db.Delete(key, nil); err != nil {
+		b.logger.Error("Error while deleting task", "key", string(key), "error", err)
+	}
+}
+
+// requeueTask atomically moves the task to its new ETA
+func (b *levelDBBroker) requeueTask(key []byte, signature *tasks.Signature, eta time.Time) {
+	signature.ETA = &eta
+
+	value, err := json.Marshal(signature)
+	if err != nil {
+		b.logger.Error("Error while encoding task", "uuid", signature.UUID, "error", err)
+		return
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Delete(key)
+	batch.Put(taskKey(eta, signature.UUID), value)
+
+	if err := b.db.Write(batch, nil); err != nil {
+		b.logger.Error("Error while requeueing task", "uuid", signature.UUID, "error", err)
+	}
+}
+
+// depth returns the number of pending tasks, including the ones in flight
+func (b *levelDBBroker) depth() (int, error) {
+	iter := b.db.NewIterator(util.BytesPrefix([]byte(taskKeyPrefix)), nil)
+	defer iter.Release()
+
+	count := 0
+	for iter.Next() {
+		count++
+	}
+
+	return count, iter.Error()
+}
+
+// PurgeLevelDBTasks removes all pending tasks from the bridge db
+func PurgeLevelDBTasks(db *leveldb.DB) (int, error) {
+	iter := db.NewIterator(util.BytesPrefix([]byte(taskKeyPrefix)), nil)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	return batch.Len(), db.Write(batch, nil)
+}
+
+// setTaskUUID sets the task uuid the way machinery does, if not set yet
+func setTaskUUID(signature *tasks.Signature) {
+	if signature.UUID == "" {
+		signature.UUID = fmt.Sprintf("task_%v", uuid.New().String())
+	}
+}
+
+func taskKey(eta time.Time, uuid string) []byte {
+	return []byte(fmt.Sprintf("%s%020d-%s", taskKeyPrefix, eta.UnixNano(), uuid))
+}
+
+func taskETA(key []byte) (time.Time, error) {
+	rest := bytes.TrimPrefix(key, []byte(taskKeyPrefix))
+	if len(rest) < 21 {
+		return time.Time{}, fmt.Errorf("invalid task key %q", key)
+	}
+
+	nanos, err := strconv.ParseInt(string(rest[:20]), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, nanos), nil
+}
+
+// decodeSignature decodes a persisted task, keeping numbers as json.Number
+// like the machinery brokers do, so the arguments reflect to their types
+func decodeSignature(value []byte, signature *tasks.Signature) error {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+
+	return decoder.Decode(signature)
+}
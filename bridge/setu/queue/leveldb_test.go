@@ -0,0 +1,212 @@
+package queue
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestBroker(t *testing.T, db *leveldb.DB) *levelDBBroker {
+	t.Helper()
+
+	return newLevelDBBroker(db, workerConcurrency, log.NewNopLogger())
+}
+
+func openTestDB(t *testing.T) (*leveldb.DB, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	db, err := leveldb.OpenFile(dir, nil)
+	require.NoError(t, err)
+
+	return db, dir
+}
+
+func countTasks(t *testing.T, db *leveldb.DB) int {
+	t.Helper()
+
+	iter := db.NewIterator(util.BytesPrefix([]byte(taskKeyPrefix)), nil)
+	defer iter.Release()
+
+	count := 0
+	for iter.Next() {
+		count++
+	}
+
+	require.NoError(t, iter.Error())
+
+	return count
+}
+
+func stringTask(name string, value string) *tasks.Signature {
+	return &tasks.Signature{
+		Name: name,
+		Args: []tasks.Arg{{Type: "string", Value: value}},
+	}
+}
+
+func TestLevelDBBrokerRunsTasks(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openTestDB(t)
+	defer db.Close()
+
+	broker := newTestBroker(t, db)
+
+	received := make(chan string, 1)
+	require.NoError(t, broker.registerTask("echo", func(value string) error {
+		received <- value
+		return nil
+	}))
+
+	broker.start()
+	defer broker.stop()
+
+	require.NoError(t, broker.sendTask(stringTask("echo", "hello")))
+
+	select {
+	case value := <-received:
+		require.Equal(t, "hello", value)
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not processed")
+	}
+
+	require.Eventually(t, func() bool { return countTasks(t, db) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLevelDBBrokerDelaysTasks(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openTestDB(t)
+	defer db.Close()
+
+	broker := newTestBroker(t, db)
+
+	processedAt := make(chan time.Time, 1)
+	require.NoError(t, broker.registerTask("delayed", func(string) error {
+		processedAt <- time.Now()
+		return nil
+	}))
+
+	broker.start()
+	defer broker.stop()
+
+	eta := time.Now().Add(500 * time.Millisecond)
+	signature := stringTask("delayed", "")
+	signature.ETA = &eta
+
+	require.NoError(t, broker.sendTask(signature))
+
+	select {
+	case at := <-processedAt:
+		require.False(t, at.Before(eta), "task processed before its ETA")
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not processed")
+	}
+}
+
+func TestLevelDBBrokerRetriesTasks(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openTestDB(t)
+	defer db.Close()
+
+	broker := newTestBroker(t, db)
+
+	var calls int32
+
+	require.NoError(t, broker.registerTask("failing", func(string) error {
+		atomic.AddInt32(&calls, 1)
+		return errors.New("failed")
+	}))
+
+	broker.start()
+	defer broker.stop()
+
+	signature := stringTask("failing", "")
+	signature.RetryCount = 1
+	setSentRetryCount(signature)
+
+	require.NoError(t, broker.sendTask(signature))
+
+	// one call and a single retry after a second, then the task is moved to
+	// the dead letters
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) == 2 && countTasks(t, db) == 0
+	}, 10*time.Second, 50*time.Millisecond)
+
+	deadLetter, err := broker.deadLetters.Get(signature.UUID)
+	require.NoError(t, err)
+	require.Equal(t, "failing", deadLetter.TaskName)
+	require.Equal(t, "failed", deadLetter.Reason)
+	require.Equal(t, 2, deadLetter.Attempts)
+}
+
+func TestLevelDBBrokerPersistsTasks(t *testing.T) {
+	t.Parallel()
+
+	db, dir := openTestDB(t)
+
+	// no worker is registered for the task, it stays in the db
+	require.NoError(t, newTestBroker(t, db).sendTask(stringTask("persisted", "value")))
+	require.NoError(t, db.Close())
+
+	db, err := leveldb.OpenFile(dir, nil)
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	require.Equal(t, 1, countTasks(t, db))
+
+	broker := newTestBroker(t, db)
+	broker.start()
+
+	defer broker.stop()
+
+	received := make(chan string, 1)
+	require.NoError(t, broker.registerTask("persisted", func(value string) error {
+		received <- value
+		return nil
+	}))
+
+	select {
+	case value := <-received:
+		require.Equal(t, "value", value)
+	case <-time.After(5 * time.Second):
+		t.Fatal("persisted task was not processed")
+	}
+}
+
+func TestPurgeLevelDBTasks(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openTestDB(t)
+	defer db.Close()
+
+	broker := newTestBroker(t, db)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, broker.sendTask(stringTask("pending", "")))
+	}
+
+	depth, err := broker.depth()
+	require.NoError(t, err)
+	require.Equal(t, 3, depth)
+
+	count, err := PurgeLevelDBTasks(db)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Equal(t, 0, countTasks(t, db))
+
+	depth, err = broker.depth()
+	require.NoError(t, err)
+	require.Equal(t, 0, depth)
+}
@@ -0,0 +1,425 @@
+package txmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+	dbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	tmCommon "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	txManagerServiceStr = "tx-manager"
+
+	// storage key prefix of the pending transactions, followed by their nonce
+	pendingTxKeyPrefix = "l1-tx-pending-"
+
+	// interval between two checks of the pending transactions
+	checkInterval = 30 * time.Second
+)
+
+// errFeeLimitReached is returned when the fees of a pending transaction
+// can't be bumped without going over the configured max fees
+var errFeeLimitReached = errors.New("max fees reached, transaction can't be replaced")
+
+// Client is the part of the main chain client used by the tx manager
+type Client interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// pendingTx is a submitted transaction waiting to be mined
+type pendingTx struct {
+	Kind string             `json:"kind"`
+	Tx   *types.Transaction `json:"tx"`
+	// hashes of every version sent with this nonce, any of them may be mined
+	Hashes []common.Hash `json:"hashes"`
+	SentAt time.Time     `json:"sentAt"`
+	Bumps  int           `json:"bumps"`
+}
+
+// TxManager watches the transactions the bridge submits to the main chain,
+// such as checkpoints and slashing ticks. A transaction still pending after
+// the bump timeout is replaced by the same transaction with bumped fees,
+// until it is mined or the max fees are reached. The pending transactions
+// are kept in the bridge db, so they are still watched after a restart.
+type TxManager struct {
+	tmCommon.BaseService
+
+	db     *leveldb.DB
+	client Client
+	signer helper.Signer
+	from   common.Address
+
+	bumpTimeout time.Duration
+	bumpPercent int64
+
+	// serializes the tracking and replacement of the transactions
+	mu sync.Mutex
+
+	cancel context.CancelFunc
+}
+
+// NewTxManager returns a tx manager for the transactions sent by the
+// validator to the main chain
+func NewTxManager() *TxManager {
+	return newTxManager(
+		util.Logger().With("module", txManagerServiceStr),
+		util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		helper.GetMainClient(),
+		helper.GetSigner(),
+		helper.GetConfig().MainchainTxBumpTimeout,
+		helper.GetConfig().MainchainTxBumpPercent,
+	)
+}
+
+func newTxManager(logger log.Logger, db *leveldb.DB, client Client, signer helper.Signer, bumpTimeout time.Duration, bumpPercent int64) *TxManager {
+	tm := &TxManager{
+		db:          db,
+		client:      client,
+		signer:      signer,
+		from:        helper.SignerAddress(signer),
+		bumpTimeout: bumpTimeout,
+		bumpPercent: bumpPercent,
+	}
+
+	tm.BaseService = *tmCommon.NewBaseService(logger, txManagerServiceStr, tm)
+
+	return tm
+}
+
+// OnStart starts watching the pending transactions
+func (tm *TxManager) OnStart() error {
+	if err := tm.BaseService.OnStart(); err != nil {
+		tm.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tm.cancel = cancel
+
+	go tm.watch(ctx)
+
+	return nil
+}
+
+// OnStop stops watching the pending transactions
+func (tm *TxManager) OnStop() {
+	tm.BaseService.OnStop() // Always call the overridden method.
+
+	if tm.cancel != nil {
+		tm.cancel()
+	}
+}
+
+// Track records a submitted transaction to replace it if it stays pending.
+// A transaction replacing another one with the same nonce is tracked along
+// with it, as either may be mined.
+func (tm *TxManager) Track(kind string, tx *types.Transaction) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	ptx := &pendingTx{
+		Kind:   kind,
+		Tx:     tx,
+		Hashes: []common.Hash{tx.Hash()},
+		SentAt: time.Now(),
+	}
+
+	previous, err := tm.getPendingTx(tx.Nonce())
+	if err != nil && !errors.Is(err, leveldb.ErrNotFound) {
+		return err
+	}
+
+	if previous != nil {
+		ptx.Hashes = append(previous.Hashes, ptx.Hashes...)
+	}
+
+	tm.Logger.Info("Tracking main chain transaction", "kind", kind, "txHash", tx.Hash(), "nonce", tx.Nonce())
+
+	return tm.putPendingTx(ptx)
+}
+
+// PendingCount returns the number of transactions waiting to be mined
+func (tm *TxManager) PendingCount() (int, error) {
+	txs, err := tm.pendingTxs()
+	return len(txs), err
+}
+
+func (tm *TxManager) watch(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := tm.checkPendingTxs(ctx); err != nil {
+				tm.Logger.Error("Error while checking pending main chain transactions", "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkPendingTxs forgets the mined transactions and replaces the ones
+// pending for longer than the bump timeout
+func (tm *TxManager) checkPendingTxs(ctx context.Context) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	txs, err := tm.pendingTxs()
+	if err != nil || len(txs) == 0 {
+		return err
+	}
+
+	nonce, err := tm.client.NonceAt(ctx, tm.from, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, ptx := range txs {
+		mined, err := tm.isMined(ctx, ptx, nonce)
+		if err != nil {
+			return err
+		}
+
+		if mined {
+			if err := tm.db.Delete(pendingTxKey(ptx.Tx.Nonce()), nil); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if time.Since(ptx.SentAt) < tm.bumpTimeout {
+			continue
+		}
+
+		if err := tm.replace(ctx, ptx); err != nil {
+			tm.Logger.Error("Error while replacing pending main chain transaction", "kind", ptx.Kind, "txHash", ptx.Tx.Hash(), "nonce", ptx.Tx.Nonce(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+// isMined checks whether any version of the transaction was mined
+func (tm *TxManager) isMined(ctx context.Context, ptx *pendingTx, nonce uint64) (bool, error) {
+	for _, hash := range ptx.Hashes {
+		receipt, err := tm.client.TransactionReceipt(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			tm.Logger.Info("Main chain transaction mined", "kind", ptx.Kind, "txHash", hash, "bumps", ptx.Bumps, "blockNumber", receipt.BlockNumber)
+		} else {
+			tm.Logger.Error("Main chain transaction failed", "kind", ptx.Kind, "txHash", hash, "bumps", ptx.Bumps, "blockNumber", receipt.BlockNumber)
+		}
+
+		return true, nil
+	}
+
+	// the nonce was used by a transaction sent outside the bridge
+	if nonce > ptx.Tx.Nonce() {
+		tm.Logger.Info("Main chain transaction nonce already used", "kind", ptx.Kind, "txHash", ptx.Tx.Hash(), "nonce", ptx.Tx.Nonce())
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// replace sends the transaction again with bumped fees
+func (tm *TxManager) replace(ctx context.Context, ptx *pendingTx) error {
+	head, err := tm.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var txData types.TxData
+
+	if ptx.Tx.Type() == types.LegacyTxType {
+		suggested, err := tm.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return err
+		}
+
+		gasPrice, err := bumpGasPrice(ptx.Tx.GasPrice(), suggested, tm.bumpPercent)
+		if err != nil {
+			return err
+		}
+
+		txData = &types.LegacyTx{
+			Nonce:    ptx.Tx.Nonce(),
+			GasPrice: gasPrice,
+			Gas:      ptx.Tx.Gas(),
+			To:       ptx.Tx.To(),
+			Value:    ptx.Tx.Value(),
+			Data:     ptx.Tx.Data(),
+		}
+	} else {
+		if head.BaseFee == nil {
+			return fmt.Errorf("no base fee in main chain block %v", head.Number)
+		}
+
+		suggested, err := tm.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return err
+		}
+
+		gasTipCap, gasFeeCap, err := bumpDynamicFees(ptx.Tx.GasTipCap(), ptx.Tx.GasFeeCap(), head.BaseFee, suggested, tm.bumpPercent)
+		if err != nil {
+			return err
+		}
+
+		txData = &types.DynamicFeeTx{
+			ChainID:    ptx.Tx.ChainId(),
+			Nonce:      ptx.Tx.Nonce(),
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        ptx.Tx.Gas(),
+			To:         ptx.Tx.To(),
+			Value:      ptx.Tx.Value(),
+			Data:       ptx.Tx.Data(),
+			AccessList: ptx.Tx.AccessList(),
+		}
+	}
+
+	tx, err := helper.SignTx(tm.signer, types.NewTx(txData), ptx.Tx.ChainId())
+	if err != nil {
+		return err
+	}
+
+	if err := tm.client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	tm.Logger.Info("Replaced pending main chain transaction", "kind", ptx.Kind, "oldTxHash", ptx.Tx.Hash(), "txHash", tx.Hash(), "nonce", tx.Nonce(), "bumps", ptx.Bumps+1)
+
+	ptx.Tx = tx
+	ptx.Hashes = append(ptx.Hashes, tx.Hash())
+	ptx.SentAt = time.Now()
+	ptx.Bumps++
+
+	return tm.putPendingTx(ptx)
+}
+
+// bumpGasPrice returns the gas price replacing a legacy transaction
+func bumpGasPrice(gasPrice *big.Int, suggested *big.Int, percent int64) (*big.Int, error) {
+	maxGasPrice, _, _ := helper.GetMainchainMaxFees()
+
+	bumped := bumpFee(gasPrice, percent)
+	if suggested = minBig(suggested, maxGasPrice); suggested.Cmp(bumped) == 1 {
+		bumped = suggested
+	}
+
+	if bumped.Cmp(maxGasPrice) == 1 {
+		return nil, errFeeLimitReached
+	}
+
+	return bumped, nil
+}
+
+// bumpDynamicFees returns the tip and fee caps replacing a dynamic fee
+// transaction. Both are bumped, as required to replace a transaction, and
+// follow the suggested tip and current base fee if higher.
+func bumpDynamicFees(gasTipCap *big.Int, gasFeeCap *big.Int, baseFee *big.Int, suggestedTip *big.Int, percent int64) (*big.Int, *big.Int, error) {
+	_, maxGasTipCap, maxGasFeeCap := helper.GetMainchainMaxFees()
+
+	tip := bumpFee(gasTipCap, percent)
+	if suggested := minBig(suggestedTip, maxGasTipCap); suggested.Cmp(tip) == 1 {
+		tip = suggested
+	}
+
+	feeCap := bumpFee(gasFeeCap, percent)
+	if current := minBig(new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip), maxGasFeeCap); current.Cmp(feeCap) == 1 {
+		feeCap = current
+	}
+
+	if tip.Cmp(maxGasTipCap) == 1 || feeCap.Cmp(maxGasFeeCap) == 1 || tip.Cmp(feeCap) == 1 {
+		return nil, nil, errFeeLimitReached
+	}
+
+	return tip, feeCap, nil
+}
+
+// bumpFee increases the fee by the given percent, rounding up
+func bumpFee(fee *big.Int, percent int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+func minBig(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) == 1 {
+		return b
+	}
+
+	return a
+}
+
+func (tm *TxManager) pendingTxs() ([]*pendingTx, error) {
+	iter := tm.db.NewIterator(dbUtil.BytesPrefix([]byte(pendingTxKeyPrefix)), nil)
+	defer iter.Release()
+
+	var txs []*pendingTx
+
+	for iter.Next() {
+		var ptx pendingTx
+		if err := json.Unmarshal(iter.Value(), &ptx); err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, &ptx)
+	}
+
+	return txs, iter.Error()
+}
+
+func (tm *TxManager) getPendingTx(nonce uint64) (*pendingTx, error) {
+	value, err := tm.db.Get(pendingTxKey(nonce), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ptx pendingTx
+	if err := json.Unmarshal(value, &ptx); err != nil {
+		return nil, err
+	}
+
+	return &ptx, nil
+}
+
+func (tm *TxManager) putPendingTx(ptx *pendingTx) error {
+	value, err := json.Marshal(ptx)
+	if err != nil {
+		return err
+	}
+
+	return tm.db.Put(pendingTxKey(ptx.Tx.Nonce()), value, nil)
+}
+
+func pendingTxKey(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", pendingTxKeyPrefix, nonce))
+}
@@ -0,0 +1,229 @@
+package txmanager
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const gwei = 1000000000
+
+var testChainID = big.NewInt(1)
+
+// fakeClient is a main chain where the sent txs stay pending until mined
+type fakeClient struct {
+	mu sync.Mutex
+
+	baseFee  *big.Int
+	tip      *big.Int
+	nonce    uint64
+	sent     []*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		baseFee:  big.NewInt(30 * gwei),
+		tip:      big.NewInt(2 * gwei),
+		receipts: make(map[common.Hash]*types.Receipt),
+	}
+}
+
+func (c *fakeClient) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(100), BaseFee: c.baseFee}, nil
+}
+
+func (c *fakeClient) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return new(big.Int).Add(c.baseFee, c.tip), nil
+}
+
+func (c *fakeClient) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return c.tip, nil
+}
+
+func (c *fakeClient) NonceAt(context.Context, common.Address, *big.Int) (uint64, error) {
+	return c.nonce, nil
+}
+
+func (c *fakeClient) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	receipt, ok := c.receipts[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return receipt, nil
+}
+
+func (c *fakeClient) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sent = append(c.sent, tx)
+
+	return nil
+}
+
+func (c *fakeClient) mine(hash common.Hash) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.receipts[hash] = &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(101)}
+}
+
+func newTestTxManager(t *testing.T, client Client) *TxManager {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	return newTxManager(log.NewNopLogger(), db, client, helper.NewLocalSigner(secp256k1.GenPrivKey()), time.Minute, 20)
+}
+
+func newTestTx(t *testing.T, tm *TxManager, nonce uint64, tip int64, feeCap int64) *types.Transaction {
+	t.Helper()
+
+	to := common.HexToAddress("0x10")
+
+	tx, err := helper.SignTx(tm.signer, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(feeCap),
+		Gas:       100000,
+		To:        &to,
+		Data:      []byte{0x01},
+	}), testChainID)
+	require.NoError(t, err)
+
+	return tx
+}
+
+// expire makes the tracked txs look pending for longer than the bump timeout
+func expire(t *testing.T, tm *TxManager) {
+	t.Helper()
+
+	txs, err := tm.pendingTxs()
+	require.NoError(t, err)
+
+	for _, ptx := range txs {
+		ptx.SentAt = ptx.SentAt.Add(-tm.bumpTimeout)
+		require.NoError(t, tm.putPendingTx(ptx))
+	}
+}
+
+func TestTxManagerReplacesPendingTx(t *testing.T) {
+	t.Parallel()
+
+	client := newFakeClient()
+	tm := newTestTxManager(t, client)
+	ctx := context.Background()
+
+	tx := newTestTx(t, tm, 5, 2*gwei, 62*gwei)
+	require.NoError(t, tm.Track("checkpoint", tx))
+
+	// not replaced before the bump timeout
+	require.NoError(t, tm.checkPendingTxs(ctx))
+	require.Empty(t, client.sent)
+
+	expire(t, tm)
+	require.NoError(t, tm.checkPendingTxs(ctx))
+	require.Len(t, client.sent, 1)
+
+	replacement := client.sent[0]
+	require.Equal(t, tx.Nonce(), replacement.Nonce())
+	require.Equal(t, tx.Data(), replacement.Data())
+	require.Equal(t, big.NewInt(2400000000), replacement.GasTipCap())
+	require.Equal(t, big.NewInt(74400000000), replacement.GasFeeCap())
+
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), replacement)
+	require.NoError(t, err)
+	require.Equal(t, tm.from, sender)
+
+	// the pending state survives a restart
+	restarted := newTxManager(log.NewNopLogger(), tm.db, client, tm.signer, time.Minute, 20)
+
+	txs, err := restarted.pendingTxs()
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, 1, txs[0].Bumps)
+	require.Equal(t, []common.Hash{tx.Hash(), replacement.Hash()}, txs[0].Hashes)
+
+	// the original tx may still be mined instead of the replacement
+	client.mine(tx.Hash())
+	require.NoError(t, restarted.checkPendingTxs(ctx))
+
+	count, err := restarted.PendingCount()
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.Len(t, client.sent, 1)
+}
+
+func TestTxManagerForgetsUsedNonce(t *testing.T) {
+	t.Parallel()
+
+	client := newFakeClient()
+	tm := newTestTxManager(t, client)
+
+	require.NoError(t, tm.Track("tick", newTestTx(t, tm, 3, 2*gwei, 62*gwei)))
+
+	client.nonce = 4
+	require.NoError(t, tm.checkPendingTxs(context.Background()))
+
+	count, err := tm.PendingCount()
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestTxManagerStopsAtMaxFees(t *testing.T) {
+	t.Parallel()
+
+	client := newFakeClient()
+	tm := newTestTxManager(t, client)
+
+	// the bumped fee cap would be over the default max fee cap of 400 gwei
+	require.NoError(t, tm.Track("checkpoint", newTestTx(t, tm, 1, 2*gwei, 350*gwei)))
+
+	expire(t, tm)
+	require.NoError(t, tm.checkPendingTxs(context.Background()))
+	require.Empty(t, client.sent)
+
+	count, err := tm.PendingCount()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestBumpDynamicFees(t *testing.T) {
+	t.Parallel()
+
+	// fees follow the base fee when it rose more than the bump
+	tip, feeCap, err := bumpDynamicFees(big.NewInt(2*gwei), big.NewInt(62*gwei), big.NewInt(100*gwei), big.NewInt(3*gwei), 10)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3*gwei), tip)
+	require.Equal(t, big.NewInt(203*gwei), feeCap)
+
+	// the suggested tip is capped
+	tip, _, err = bumpDynamicFees(big.NewInt(2*gwei), big.NewInt(62*gwei), big.NewInt(30*gwei), big.NewInt(1000*gwei), 10)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(50*gwei), tip)
+
+	_, _, err = bumpDynamicFees(big.NewInt(48*gwei), big.NewInt(100*gwei), big.NewInt(30*gwei), big.NewInt(2*gwei), 10)
+	require.ErrorIs(t, err, errFeeLimitReached)
+}
@@ -37,6 +37,7 @@ type BridgeEvent string
 
 const (
 	AccountDetailsURL       = "/auth/accounts/%v"
+	AuthParamsURL           = "/auth/params"
 	LastNoAckURL            = "/checkpoints/last-no-ack"
 	CheckpointParamsURL     = "/checkpoints/params"
 	MilestoneParamsURL      = "/milestone/params"
@@ -64,9 +65,12 @@ const (
 	SlashingTxStatusURL     = "/slashing/isoldtx"
 	SlashingTickCountURL    = "/slashing/tick-count"
 
-	TendermintUnconfirmedTxsURL      = "/unconfirmed_txs"
+	TendermintUnconfirmedTxsURL      = "/unconfirmed_txs?limit=%d"
 	TendermintUnconfirmedTxsCountURL = "/num_unconfirmed_txs"
 
+	// TendermintMaxUnconfirmedTxs is the max number of txs listed by tendermint, it doesn't page the mempool
+	TendermintMaxUnconfirmedTxs = 100
+
 	TransactionTimeout      = 1 * time.Minute
 	CommitTimeout           = 2 * time.Minute
 	TaskDelayBetweenEachVal = 10 * time.Second
@@ -208,6 +212,39 @@ func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error)
 	return false, nil
 }
 
+// GetProposerRank returns our position among the next count proposers after
+// the current one, starting at 1. It is 0 if we are not one of them.
+func GetProposerRank(cliCtx cliContext.CLIContext, count uint64) (uint64, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ProposersURL, strconv.FormatUint(count+1, 10))),
+	)
+	if err != nil {
+		logger.Error("Unable to send request for next proposers", "url", ProposersURL, "error", err)
+		return 0, err
+	}
+
+	var proposers []hmtypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &proposers); err != nil {
+		logger.Error("Error unmarshalling validator data ", "error", err)
+		return 0, err
+	}
+
+	return proposerRank(proposers, helper.GetAddress(), count), nil
+}
+
+// proposerRank returns the position of the signer among the count proposers
+// following the current one, starting at 1. It is 0 if it is not one of them.
+func proposerRank(proposers []hmtypes.Validator, signer []byte, count uint64) uint64 {
+	for i := 1; i <= int(count) && i < len(proposers); i++ {
+		if bytes.Equal(proposers[i].Signer.Bytes(), signer) {
+			return uint64(i)
+		}
+	}
+
+	return 0
+}
+
 // IsInProposerList checks if we are in current proposer
 func IsInMilestoneProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error) {
 	logger.Debug("Skipping proposers", "count", strconv.FormatUint(count, 10))
@@ -411,6 +448,26 @@ func GetAccount(cliCtx cliContext.CLIContext, address types.HeimdallAddress) (ac
 	return
 }
 
+// GetAuthParams return auth params
+func GetAuthParams(cliCtx cliContext.CLIContext) (*authTypes.Params, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(AuthParamsURL),
+	)
+	if err != nil {
+		logger.Error("Error fetching auth params", "err", err)
+		return nil, err
+	}
+
+	var params authTypes.Params
+	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &params); err != nil {
+		logger.Error("Error unmarshalling auth params", "url", AuthParamsURL, "err", err)
+		return nil, err
+	}
+
+	return &params, nil
+}
+
 // GetChainmanagerParams return chain manager params
 func GetChainmanagerParams(cliCtx cliContext.CLIContext) (*chainManagerTypes.Params, error) {
 	response, err := helper.FetchFromAPI(
@@ -452,8 +509,8 @@ func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params,
 	return &params, nil
 }
 
-// GetCheckpointParams return params
-func GetMilestoneParams(cliCtx cliContext.CLIContext) (*milestoneTypes.Params, error) {
+// GetMilestoneParams return milestone params
+func GetMilestoneParams(cliCtx cliContext.CLIContext) (*milestoneTypes.MilestoneParams, error) {
 	response, err := helper.FetchFromAPI(
 		cliCtx,
 		helper.GetHeimdallServerEndpoint(MilestoneParamsURL),
@@ -464,9 +521,9 @@ func GetMilestoneParams(cliCtx cliContext.CLIContext) (*milestoneTypes.Params, e
 		return nil, err
 	}
 
-	var params milestoneTypes.Params
+	var params milestoneTypes.MilestoneParams
 	if err := json.Unmarshal(response.Result, &params); err != nil {
-		logger.Error("Error unmarshalling Checkpoint params", "url", MilestoneParamsURL)
+		logger.Error("Error unmarshalling Milestone params", "url", MilestoneParamsURL)
 		return nil, err
 	}
 
@@ -675,6 +732,59 @@ func GetUnconfirmedTxnCount(event interface{}) int {
 	return count
 }
 
+// GetUnconfirmedTxs returns the first TendermintMaxUnconfirmedTxs txs in the
+// mempool, along with the number of txs in the mempool
+func GetUnconfirmedTxs() ([][]byte, int, error) {
+	endpoint := helper.GetConfig().TendermintRPCUrl + fmt.Sprintf(TendermintUnconfirmedTxsURL, TendermintMaxUnconfirmedTxs)
+
+	resp, err := helper.Client.Get(endpoint)
+	if err != nil {
+		logger.Error("Error fetching mempool txs", "url", endpoint, "error", err)
+		return nil, 0, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	defer resp.Body.Close()
+
+	if err != nil {
+		logger.Error("Error fetching mempool txs", "error", err)
+		return nil, 0, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("error fetching mempool txs, status code %d", resp.StatusCode)
+	}
+
+	// a minimal response of the unconfirmed txs
+	var response TendermintUnconfirmedTxs
+
+	if err = jsoniter.ConfigFastest.Unmarshal(body, &response); err != nil {
+		logger.Error("Error unmarshalling response received from Heimdall Server", "error", err)
+		return nil, 0, err
+	}
+
+	total, err := strconv.Atoi(response.Result.Total)
+	if err != nil {
+		logger.Error("Error parsing the number of mempool txs", "total", response.Result.Total, "error", err)
+		return nil, 0, err
+	}
+
+	txs := make([][]byte, 0, len(response.Result.Txs))
+
+	for _, txn := range response.Result.Txs {
+		// Tendermint encodes the transactions with base64 encoding. Decode it first.
+		txBytes, err := helper.TendermintTxDecode(txn)
+		if err != nil {
+			logger.Error("Error decoding mempool tx", "error", err)
+			continue
+		}
+
+		txs = append(txs, txBytes)
+	}
+
+	return txs, total, nil
+}
+
 // LogElapsedTimeForStateSyncedEvent logs useful info for StateSynced events
 func LogElapsedTimeForStateSyncedEvent(event interface{}, functionName string, startTime time.Time) {
 	if event == nil {
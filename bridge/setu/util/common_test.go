@@ -0,0 +1,32 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hmtypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestProposerRank(t *testing.T) {
+	t.Parallel()
+
+	signers := []hmtypes.HeimdallAddress{
+		hmtypes.HexToHeimdallAddress("0x01"),
+		hmtypes.HexToHeimdallAddress("0x02"),
+		hmtypes.HexToHeimdallAddress("0x03"),
+		hmtypes.HexToHeimdallAddress("0x04"),
+	}
+
+	// the current proposer comes first, then the next ones
+	proposers := make([]hmtypes.Validator, 0, len(signers))
+	for _, signer := range signers {
+		proposers = append(proposers, hmtypes.Validator{Signer: signer})
+	}
+
+	require.Equal(t, uint64(0), proposerRank(proposers, signers[0].Bytes(), 2), "Current proposer isn't a backup")
+	require.Equal(t, uint64(1), proposerRank(proposers, signers[1].Bytes(), 2))
+	require.Equal(t, uint64(2), proposerRank(proposers, signers[2].Bytes(), 2))
+	require.Equal(t, uint64(0), proposerRank(proposers, signers[3].Bytes(), 2), "Only the next count proposers are backups")
+	require.Equal(t, uint64(0), proposerRank(proposers, hmtypes.HexToHeimdallAddress("0x05").Bytes(), 10), "Unknown signer isn't a backup")
+}
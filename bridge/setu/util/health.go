@@ -0,0 +1,228 @@
+package util
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// ListenerModePolling - listener polls the chain for new blocks
+	ListenerModePolling = "polling"
+	// ListenerModeSubscribed - listener gets new blocks through a subscription
+	ListenerModeSubscribed = "subscribed"
+
+	// a listener is stale once it did not see the chain head for this many intervals
+	listenerStaleIntervals = 5
+	// minimum time before a listener is considered stale
+	listenerMinStaleAfter = time.Minute
+)
+
+// ListenerHealth is the state of a chain listener
+type ListenerHealth struct {
+	Name               string        `json:"name"`
+	Mode               string        `json:"mode"`
+	ChainHead          uint64        `json:"chain_head"`
+	LastProcessedBlock uint64        `json:"last_processed_block"`
+	Lag                uint64        `json:"lag"`
+	LastSeenHead       time.Time     `json:"last_seen_head"`
+	Interval           time.Duration `json:"-"`
+	Stale              bool          `json:"stale"`
+}
+
+// ProcessorHealth is the state of a processor
+type ProcessorHealth struct {
+	Name               string    `json:"name"`
+	LastTask           string    `json:"last_task,omitempty"`
+	LastSuccessfulTask time.Time `json:"last_successful_task"`
+}
+
+// healthRegistry keeps the state reported by listeners and processors
+type healthRegistry struct {
+	mu         sync.RWMutex
+	listeners  map[string]*ListenerHealth
+	processors map[string]*ProcessorHealth
+}
+
+var bridgeHealth = newHealthRegistry()
+
+func newHealthRegistry() *healthRegistry {
+	return &healthRegistry{
+		listeners:  make(map[string]*ListenerHealth),
+		processors: make(map[string]*ProcessorHealth),
+	}
+}
+
+// SetListenerMode records how the listener gets new blocks and how often it
+// is expected to see the chain head
+func SetListenerMode(name string, mode string, interval time.Duration) {
+	bridgeHealth.setListenerMode(name, mode, interval)
+}
+
+// SetListenerHead records the chain head seen by the listener
+func SetListenerHead(name string, head uint64) {
+	bridgeHealth.setListenerHead(name, head, time.Now())
+}
+
+// SetListenerProcessed records the last block processed by the listener
+func SetListenerProcessed(name string, block uint64) {
+	bridgeHealth.setListenerProcessed(name, block)
+}
+
+// RecordTaskSuccess records a successful task run of the processor
+func RecordTaskSuccess(processor string, task string) {
+	bridgeHealth.recordTaskSuccess(processor, task, time.Now())
+}
+
+// ListenersHealth returns the state of all started listeners, sorted by name
+func ListenersHealth() []ListenerHealth {
+	return bridgeHealth.listenersHealth(time.Now())
+}
+
+// ProcessorsHealth returns the state of all processors with a successful
+// task, sorted by name
+func ProcessorsHealth() []ProcessorHealth {
+	return bridgeHealth.processorsHealth()
+}
+
+// TrackTask wraps the task function so its successful runs are recorded for
+// the processor. Task functions return an error as their last value.
+func TrackTask(processor string, task string, taskFunc interface{}) interface{} {
+	fn := reflect.ValueOf(taskFunc)
+	if fn.Kind() != reflect.Func || fn.Type().NumOut() == 0 {
+		// left to the task validation of the queue
+		return taskFunc
+	}
+
+	return reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		var results []reflect.Value
+		if fn.Type().IsVariadic() {
+			results = fn.CallSlice(args)
+		} else {
+			results = fn.Call(args)
+		}
+
+		if last := results[len(results)-1]; last.IsNil() {
+			RecordTaskSuccess(processor, task)
+		}
+
+		return results
+	}).Interface()
+}
+
+func (r *healthRegistry) listener(name string) *ListenerHealth {
+	l, ok := r.listeners[name]
+	if !ok {
+		l = &ListenerHealth{Name: name}
+		r.listeners[name] = l
+	}
+
+	return l
+}
+
+func (r *healthRegistry) setListenerMode(name string, mode string, interval time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l := r.listener(name)
+	l.Mode = mode
+	l.Interval = interval
+}
+
+func (r *healthRegistry) setListenerHead(name string, head uint64, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l := r.listener(name)
+	l.ChainHead = head
+	l.LastSeenHead = now
+}
+
+func (r *healthRegistry) setListenerProcessed(name string, block uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.listener(name).LastProcessedBlock = block
+}
+
+func (r *healthRegistry) recordTaskSuccess(processor string, task string, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.processors[processor] = &ProcessorHealth{
+		Name:               processor,
+		LastTask:           task,
+		LastSuccessfulTask: now,
+	}
+}
+
+func (r *healthRegistry) listenersHealth(now time.Time) []ListenerHealth {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]ListenerHealth, 0, len(r.listeners))
+
+	for _, l := range r.listeners {
+		// only listeners which were started are reported
+		if l.Mode == "" {
+			continue
+		}
+
+		health := *l
+		if health.ChainHead > health.LastProcessedBlock {
+			health.Lag = health.ChainHead - health.LastProcessedBlock
+		}
+
+		staleAfter := listenerStaleIntervals * health.Interval
+		if staleAfter < listenerMinStaleAfter {
+			staleAfter = listenerMinStaleAfter
+		}
+
+		health.Stale = health.LastSeenHead.IsZero() || now.Sub(health.LastSeenHead) > staleAfter
+
+		result = append(result, health)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func (r *healthRegistry) processorsHealth() []ProcessorHealth {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]ProcessorHealth, 0, len(r.processors))
+	for _, p := range r.processors {
+		result = append(result, *p)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// ListenersNotReady returns why the listeners are not ready, if they are not
+func ListenersNotReady(listeners []ListenerHealth) []string {
+	if len(listeners) == 0 {
+		return []string{"no listener started"}
+	}
+
+	var reasons []string
+
+	for _, l := range listeners {
+		if l.Stale && l.LastSeenHead.IsZero() {
+			reasons = append(reasons, fmt.Sprintf("%s listener did not see the chain head yet", l.Name))
+		} else if l.Stale {
+			reasons = append(reasons, fmt.Sprintf("%s listener did not see the chain head since %v", l.Name, l.LastSeenHead.Format(time.RFC3339)))
+		}
+	}
+
+	return reasons
+}
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthRegistryListeners(t *testing.T) {
+	t.Parallel()
+
+	r := newHealthRegistry()
+	now := time.Now()
+
+	// listeners are only reported once started
+	r.setListenerHead("rootchain", 100, now)
+	require.Empty(t, r.listenersHealth(now))
+	require.Equal(t, []string{"no listener started"}, ListenersNotReady(r.listenersHealth(now)))
+
+	r.setListenerMode("rootchain", ListenerModePolling, time.Minute)
+	r.setListenerProcessed("rootchain", 90)
+	r.setListenerMode("heimdall", ListenerModeSubscribed, 0)
+
+	listeners := r.listenersHealth(now)
+	require.Len(t, listeners, 2)
+
+	require.Equal(t, "heimdall", listeners[0].Name)
+	require.Equal(t, ListenerModeSubscribed, listeners[0].Mode)
+	require.True(t, listeners[0].Stale)
+
+	require.Equal(t, "rootchain", listeners[1].Name)
+	require.Equal(t, uint64(100), listeners[1].ChainHead)
+	require.Equal(t, uint64(90), listeners[1].LastProcessedBlock)
+	require.Equal(t, uint64(10), listeners[1].Lag)
+	require.False(t, listeners[1].Stale)
+
+	require.Len(t, ListenersNotReady(listeners), 1)
+
+	r.setListenerHead("heimdall", 5, now)
+	require.Empty(t, ListenersNotReady(r.listenersHealth(now)))
+
+	// stale once the head was not seen for a few intervals
+	listeners = r.listenersHealth(now.Add(listenerStaleIntervals*time.Minute + time.Second))
+	require.True(t, listeners[0].Stale)
+	require.True(t, listeners[1].Stale)
+	require.Len(t, ListenersNotReady(listeners), 2)
+}
+
+func TestTrackTask(t *testing.T) {
+	t.Parallel()
+
+	taskErr := errors.New("failed")
+
+	tracked := TrackTask("health-test", "task", func(fail bool) error {
+		if fail {
+			return taskErr
+		}
+
+		return nil
+	}).(func(bool) error)
+
+	require.Equal(t, taskErr, tracked(true))
+
+	for _, p := range ProcessorsHealth() {
+		require.NotEqual(t, "health-test", p.Name)
+	}
+
+	require.NoError(t, tracked(false))
+
+	var found bool
+
+	for _, p := range ProcessorsHealth() {
+		if p.Name == "health-test" {
+			found = true
+
+			require.Equal(t, "task", p.LastTask)
+			require.False(t, p.LastSuccessfulTask.IsZero())
+		}
+	}
+
+	require.True(t, found)
+}
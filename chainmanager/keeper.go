@@ -1,6 +1,8 @@
 package chainmanager
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -53,8 +55,20 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the chainmanager module's parameters.
+// GetParams gets the chainmanager module's parameters. MainchainFinality was
+// added after genesis and keeps its default value until it is set, the other
+// parameters must be in the store.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params.MainchainFinality = types.DefaultMainchainFinality
+
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyMainchainFinality) {
+			k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+			continue
+		}
+
+		k.paramSpace.Get(ctx, pair.Key, pair.Value)
+	}
+
 	return
 }
@@ -4,8 +4,13 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -38,3 +43,52 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestMainchainFinality() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	params := types.DefaultParams()
+	params.MainchainFinality = types.MainchainFinalitySafe
+
+	app.ChainKeeper.SetParams(ctx, params)
+
+	actualParams := app.ChainKeeper.GetParams(ctx)
+	require.Equal(t, types.MainchainFinalitySafe, actualParams.GetMainchainFinality())
+
+	// params stored before MainchainFinality was added keep its default value
+	store := ctx.KVStore(app.GetKey(paramsTypes.StoreKey))
+	store.Delete(append([]byte(types.DefaultParamspace+"/"), types.KeyMainchainFinality...))
+
+	actualParams = app.ChainKeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultMainchainFinality, actualParams.MainchainFinality)
+	require.Equal(t, params.ChainParams, actualParams.ChainParams)
+
+	params.MainchainFinality = ""
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultMainchainFinality, params.GetMainchainFinality())
+
+	params.MainchainFinality = "latest"
+	require.Error(t, params.Validate())
+}
+
+func (suite *KeeperTestSuite) TestGetMainchainTxReceipt() {
+	t := suite.T()
+	txHash := common.HexToHash("0x01")
+	receipt := &ethTypes.Receipt{TxHash: txHash}
+
+	contractCaller := &mocks.IContractCaller{}
+	contractCaller.On("GetConfirmedTxReceipt", txHash, types.DefaultMainchainTxConfirmations).Return(receipt, nil).Once()
+	contractCaller.On("GetFinalizedTxReceipt", txHash, rpc.FinalizedBlockNumber).Return(receipt, nil).Once()
+	contractCaller.On("GetFinalizedTxReceipt", txHash, rpc.SafeBlockNumber).Return(receipt, nil).Once()
+
+	params := types.DefaultParams()
+
+	for _, finality := range []string{types.MainchainFinalityConfirmations, types.MainchainFinalityFinalized, types.MainchainFinalitySafe} {
+		params.MainchainFinality = finality
+
+		actualReceipt, err := params.GetMainchainTxReceipt(contractCaller, txHash)
+		require.NoError(t, err)
+		require.Equal(t, receipt, actualReceipt)
+	}
+
+	contractCaller.AssertExpectations(t)
+}
@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -17,6 +21,19 @@ const (
 	DefaultMaticchainMilestoneTxConfirmations uint64 = 16
 )
 
+// Main chain finality modes, deciding when a main chain tx is considered final
+const (
+	// MainchainFinalityConfirmations waits for the finalized block if available,
+	// or for the main chain tx confirmations otherwise
+	MainchainFinalityConfirmations = "confirmations"
+	// MainchainFinalityFinalized waits for the finalized block
+	MainchainFinalityFinalized = "finalized"
+	// MainchainFinalitySafe waits for the safe block
+	MainchainFinalitySafe = "safe"
+
+	DefaultMainchainFinality = MainchainFinalityConfirmations
+)
+
 var (
 	DefaultStateReceiverAddress hmTypes.HeimdallAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000001001")
 	DefaultValidatorSetAddress  hmTypes.HeimdallAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000001000")
@@ -27,6 +44,7 @@ var (
 	KeyMainchainTxConfirmations  = []byte("MainchainTxConfirmations")
 	KeyMaticchainTxConfirmations = []byte("MaticchainTxConfirmations")
 	KeyChainParams               = []byte("ChainParams")
+	KeyMainchainFinality         = []byte("MainchainFinality")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -65,6 +83,7 @@ type Params struct {
 	MainchainTxConfirmations  uint64      `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
 	MaticchainTxConfirmations uint64      `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
 	ChainParams               ChainParams `json:"chain_params" yaml:"chain_params"`
+	MainchainFinality         string      `json:"mainchain_finality" yaml:"mainchain_finality"`
 }
 
 // NewParams creates a new Params object
@@ -73,6 +92,7 @@ func NewParams(mainchainTxConfirmations uint64, maticchainTxConfirmations uint64
 		MainchainTxConfirmations:  mainchainTxConfirmations,
 		MaticchainTxConfirmations: maticchainTxConfirmations,
 		ChainParams:               chainParams,
+		MainchainFinality:         DefaultMainchainFinality,
 	}
 }
 
@@ -84,6 +104,7 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyMainchainTxConfirmations, &p.MainchainTxConfirmations},
 		{KeyMaticchainTxConfirmations, &p.MaticchainTxConfirmations},
 		{KeyChainParams, &p.ChainParams},
+		{KeyMainchainFinality, &p.MainchainFinality},
 	}
 }
 
@@ -103,6 +124,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("MainchainTxConfirmations: %d\n", p.MainchainTxConfirmations))
 	sb.WriteString(fmt.Sprintf("MaticchainTxConfirmations: %d\n", p.MaticchainTxConfirmations))
 	sb.WriteString(fmt.Sprintf("ChainParams: %s\n", p.ChainParams.String()))
+	sb.WriteString(fmt.Sprintf("MainchainFinality: %s\n", p.GetMainchainFinality()))
 
 	return sb.String()
 }
@@ -141,9 +163,37 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	switch p.MainchainFinality {
+	case "", MainchainFinalityConfirmations, MainchainFinalityFinalized, MainchainFinalitySafe:
+	default:
+		return fmt.Errorf("Invalid value %s in mainchain_finality", p.MainchainFinality)
+	}
+
 	return nil
 }
 
+// GetMainchainFinality returns the main chain finality mode, the default if it isn't set
+func (p Params) GetMainchainFinality() string {
+	if p.MainchainFinality == "" {
+		return DefaultMainchainFinality
+	}
+
+	return p.MainchainFinality
+}
+
+// GetMainchainTxReceipt returns the receipt of a main chain tx once it is
+// final according to the main chain finality mode
+func (p Params) GetMainchainTxReceipt(contractCaller helper.IContractCaller, txHash common.Hash) (*ethTypes.Receipt, error) {
+	switch p.GetMainchainFinality() {
+	case MainchainFinalityFinalized:
+		return contractCaller.GetFinalizedTxReceipt(txHash, rpc.FinalizedBlockNumber)
+	case MainchainFinalitySafe:
+		return contractCaller.GetFinalizedTxReceipt(txHash, rpc.SafeBlockNumber)
+	default:
+		return contractCaller.GetConfirmedTxReceipt(txHash, p.MainchainTxConfirmations)
+	}
+}
+
 func validateHeimdallAddress(key string, value hmTypes.HeimdallAddress) error {
 	if value.String() == "" {
 		return fmt.Errorf("Invalid value %s in chain_params", key)
@@ -171,5 +221,6 @@ func DefaultParams() Params {
 			StateReceiverAddress: DefaultStateReceiverAddress,
 			ValidatorSetAddress:  DefaultValidatorSetAddress,
 		},
+		MainchainFinality: DefaultMainchainFinality,
 	}
 }
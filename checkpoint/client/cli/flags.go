@@ -14,4 +14,6 @@ const (
 	FlagAutoConfigure      = "auto-configure"
 	FlagLimit              = "limit"
 	FlagPage               = "page"
+	FlagBlockNumber        = "block-number"
+	FlagMilestoneID        = "milestone-id"
 )
@@ -39,12 +39,19 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	supplyQueryCmd.AddCommand(
 		client.GetCommands(
 			GetQueryParams(cdc),
+			GetQueryMilestoneParams(cdc),
 			GetCheckpointBuffer(cdc),
 			GetLastNoACK(cdc),
 			GetCheckpointByNumber(cdc),
 			GetCheckpointCount(cdc),
 			GetCheckpointLatest(cdc),
 			GetCheckpointList(cdc),
+			GetExitProof(cdc),
+			GetBorBlockStatus(cdc),
+			GetMilestoneList(cdc),
+			GetMilestoneByID(cdc),
+			GetMilestoneByBorBlock(cdc),
+			GetMilestoneStats(cdc),
 			GetOverview(cdc),
 		)...,
 	)
@@ -86,6 +93,40 @@ $ %s query checkpoint params
 	}
 }
 
+// GetQueryMilestoneParams implements the milestone params query command.
+func GetQueryMilestoneParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "milestone-params",
+		Args:  cobra.NoArgs,
+		Short: "show the current milestone parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as milestone parameters.
+
+Example:
+$ %s query checkpoint milestone-params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneParameters)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.MilestoneParams
+			if err := jsoniter.ConfigFastest.Unmarshal(bz, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
 // GetCheckpointBuffer get checkpoint present in buffer
 func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -365,6 +406,211 @@ func GetCheckpointList(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetExitProof get the exit proof of a bor block or of a log of a bor tx
+func GetExitProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-proof",
+		Short: "get exit proof of a bor block, or of a bor tx log with its exit payload",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			blockNumber := viper.GetUint64(FlagBlockNumber)
+			txHash := viper.GetString(FlagCheckpointTxHash)
+
+			if txHash == "" && !cmd.Flags().Changed(FlagBlockNumber) {
+				return fmt.Errorf("block number or tx hash is required")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryExitProofParams(blockNumber, txHash, viper.GetUint64(FlagCheckpointLogIndex)))
+			if err != nil {
+				return err
+			}
+
+			// query exit proof
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryExitProof), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block-number=<bor block number>")
+	cmd.Flags().String(FlagCheckpointTxHash, "", "--txhash=<bor tx hash>")
+	cmd.Flags().Uint64(FlagCheckpointLogIndex, 0, "--log-index=<log index in the tx receipt>")
+
+	return cmd
+}
+
+// GetBorBlockStatus get the checkpoint of a bor block, and whether a milestone covers it
+func GetBorBlockStatus(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bor-block",
+		Short: "show whether a bor block is checkpointed, with its checkpoint, or covered by a milestone",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if !cmd.Flags().Changed(FlagBlockNumber) {
+				return fmt.Errorf("block number is required")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(viper.GetUint64(FlagBlockNumber)))
+			if err != nil {
+				return err
+			}
+
+			// query bor block status
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBorBlockStatus), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block-number=<bor block number>")
+
+	return cmd
+}
+
+// GetMilestoneList get the page of the milestone history
+func GetMilestoneList(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone-list",
+		Short: "get milestone history list, from the oldest milestone kept",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(viper.GetUint64(FlagPage), viper.GetUint64(FlagLimit)))
+			if err != nil {
+				return err
+			}
+
+			// query milestone list
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneList), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPage, 1, "--page=<page number here>")
+	cmd.Flags().Uint64(FlagLimit, 20, "--limit=<limit here>")
+
+	return cmd
+}
+
+// GetMilestoneByID get the milestone of the history with the milestone id
+func GetMilestoneByID(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone-by-id",
+		Short: "get milestone of the history by milestone id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			milestoneID := viper.GetString(FlagMilestoneID)
+			if milestoneID == "" {
+				return fmt.Errorf("milestone id is required")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryMilestoneID(milestoneID))
+			if err != nil {
+				return err
+			}
+
+			// query milestone
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneByID), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagMilestoneID, "", "--milestone-id=<milestone id>")
+
+	return cmd
+}
+
+// GetMilestoneByBorBlock get the milestone of the history covering a bor block
+func GetMilestoneByBorBlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone-by-bor-block",
+		Short: "get milestone of the history covering a bor block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if !cmd.Flags().Changed(FlagBlockNumber) {
+				return fmt.Errorf("block number is required")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(viper.GetUint64(FlagBlockNumber)))
+			if err != nil {
+				return err
+			}
+
+			// query milestone
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneByBorBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block-number=<bor block number>")
+
+	return cmd
+}
+
+// GetMilestoneStats get the milestone stats of a proposer, or of all the proposers
+func GetMilestoneStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone-stats",
+		Short: "get milestone stats (proposed, accepted, timed out, no-acked, average length) of a proposer, or of all the proposers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryMilestoneStatsParams(proposer))
+			if err != nil {
+				return err
+			}
+
+			// query milestone stats
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneStats), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagProposerAddress, "", "--proposer=<proposer address>")
+
+	return cmd
+}
+
 type stateDump struct {
 	ACKCount         uint64               `json:"ack_count"`
 	CheckpointBuffer *hmTypes.Checkpoint  `json:"checkpoint_buffer"`
@@ -131,6 +131,32 @@ type checkpointCount struct {
 	Result int64 `json:"result"`
 }
 
+// It represents the exit proof
+//
+//swagger:response exitProofResponse
+type exitProofResponse struct {
+	//in:body
+	Output exitProofStructure `json:"output"`
+}
+
+type exitProofStructure struct {
+	Height string          `json:"height"`
+	Result types.ExitProof `json:"result"`
+}
+
+// It represents the checkpoint and milestone status of a bor block
+//
+//swagger:response borBlockStatusResponse
+type borBlockStatusResponse struct {
+	//in:body
+	Output borBlockStatusStructure `json:"output"`
+}
+
+type borBlockStatusStructure struct {
+	Height string               `json:"height"`
+	Result types.BorBlockStatus `json:"result"`
+}
+
 // It represents the overview
 //
 //swagger:response overviewResponse
@@ -187,6 +213,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 	r.HandleFunc("/checkpoints/list", checkpointListhandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/exit-proof", exitProofHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/bor-block/{number}", borBlockStatusHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/{number}", checkpointByNumberHandlerFunc(cliCtx)).Methods("GET")
 
 	registerQueryMilestoneRoutes(cliCtx, r)
@@ -818,7 +848,122 @@ func checkpointListhandlerFn(
 	}
 }
 
-//swagger:parameters checkpointList checkpointById checkpointLatest overview checkpointLastNoAck checkpointPrepare checkpointCount checkpointParams checkpointBuffer
+//swagger:parameters checkpointExitProof
+type exitProofParams struct {
+
+	//Bor block number, ignored when a tx hash is given
+	//in:query
+	BlockNumber int64 `json:"block_number"`
+
+	//Bor tx hash
+	//in:query
+	TxHash string `json:"tx_hash"`
+
+	//Index of the log in the tx receipt
+	//in:query
+	LogIndex int64 `json:"log_index"`
+}
+
+// swagger:route GET /checkpoints/exit-proof checkpoint checkpointExitProof
+// It returns the proof of a bor block in its checkpoint, and the exit payload of a bor tx log
+// responses:
+//
+//	200: exitProofResponse
+func exitProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var (
+			blockNumber uint64
+			logIndex    uint64
+			txHash      = vars.Get("tx_hash")
+		)
+
+		if txHash == "" {
+			if blockNumber, ok = rest.ParseUint64OrReturnBadRequest(w, vars.Get("block_number")); !ok {
+				return
+			}
+		} else if vars.Get("log_index") != "" {
+			if logIndex, ok = rest.ParseUint64OrReturnBadRequest(w, vars.Get("log_index")); !ok {
+				return
+			}
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryExitProofParams(blockNumber, txHash, logIndex))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query exit proof
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryExitProof), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters checkpointBorBlockStatus
+type borBlockNumber struct {
+
+	//Bor block number
+	//required:true
+	//in:path
+	Number int64 `json:"number"`
+}
+
+// swagger:route GET /checkpoints/bor-block/{number} checkpoint checkpointBorBlockStatus
+// It returns whether a bor block is checkpointed, with its checkpoint, and whether a milestone covers it
+// responses:
+//
+//	200: borBlockStatusResponse
+func borBlockStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(number))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query bor block status
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBorBlockStatus), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters checkpointList checkpointById checkpointLatest overview checkpointLastNoAck checkpointPrepare checkpointCount checkpointParams checkpointBuffer checkpointExitProof checkpointBorBlockStatus
 type Height struct {
 
 	//Block Height
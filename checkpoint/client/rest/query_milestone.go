@@ -11,13 +11,19 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
 )
 
 func registerQueryMilestoneRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/milestone/latest", milestoneLatestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/count", milestoneCountHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/params", milestoneParamsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/lastNoAck", latestNoAckMilestoneHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/list", milestoneListHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/stats", milestoneStatsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/by-id/{id}", milestoneRecordByIDHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/bor-block/{number}", milestoneByBorBlockHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/{number}", milestoneByNumberHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/noAck/{id}", noAckMilestoneByIDHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/ID/{id}", milestoneByIDHandlerFn(cliCtx)).Methods("GET")
@@ -52,6 +58,29 @@ func milestoneLatestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// swagger:route GET /milestone/params milestone milestoneParams
+// It returns the milestone parameters
+// responses:
+//
+//	200: milestoneParamsResponse
+func milestoneParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneParameters), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // swagger:route GET /milestone/count milestone milestoneCount
 // It returns the milestone count
 // responses:
@@ -269,3 +298,196 @@ func milestoneByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// swagger:route GET /milestone/list milestone milestoneList
+// It returns the page of the milestone history, from the oldest milestone kept
+// responses:
+//
+//	200: milestoneListResponse
+func milestoneListHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get page
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query milestone list
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneList), queryParams)
+
+		// Return status code 503 (Service Unavailable) if HF hasn't been activated
+		if height < helper.GetAalborgHardForkHeight() {
+			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
+
+			return
+		}
+
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// swagger:route GET /milestone/by-id/{id} milestone milestoneRecordById
+// It returns the milestone of the history with the milestone id
+// responses:
+//
+//	200: milestoneRecordResponse
+func milestoneRecordByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryMilestoneID(vars["id"]))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query milestone
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneByID), queryParams)
+
+		// Return status code 503 (Service Unavailable) if HF hasn't been activated
+		if height < helper.GetAalborgHardForkHeight() {
+			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
+
+			return
+		}
+
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// swagger:route GET /milestone/bor-block/{number} milestone milestoneByBorBlock
+// It returns the milestone of the history covering the bor block
+// responses:
+//
+//	200: milestoneRecordResponse
+func milestoneByBorBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(number))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query milestone
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneByBorBlock), queryParams)
+
+		// Return status code 503 (Service Unavailable) if HF hasn't been activated
+		if height < helper.GetAalborgHardForkHeight() {
+			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
+
+			return
+		}
+
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// swagger:route GET /milestone/stats milestone milestoneStats
+// It returns the milestone stats of the proposer, or of all the proposers without one
+// responses:
+//
+//	200: milestoneStatsResponse
+func milestoneStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get query params
+		proposer := hmTypes.HexToHeimdallAddress(r.URL.Query().Get("proposer"))
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryMilestoneStatsParams(proposer))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query milestone stats
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneStats), queryParams)
+
+		// Return status code 503 (Service Unavailable) if HF hasn't been activated
+		if height < helper.GetAalborgHardForkHeight() {
+			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "Aalborg hardfork not activated yet")
+
+			return
+		}
+
+		// Return status code 503 (Service Unavailable) if the stats aren't kept in state yet
+		if height < helper.GetMilestoneHistoryHeight() {
+			hmRest.WriteErrorResponse(w, http.StatusServiceUnavailable, "milestone history hardfork not activated yet")
+
+			return
+		}
+
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
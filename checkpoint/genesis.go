@@ -13,6 +13,11 @@ import (
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
+	// genesis files from before the milestone params keep the defaults
+	if data.MilestoneParams != (types.MilestoneParams{}) {
+		keeper.SetMilestoneParams(ctx, data.MilestoneParams)
+	}
+
 	// Set last no-ack
 	if data.LastNoACK > 0 {
 		keeper.SetLastNoAck(ctx, data.LastNoACK)
@@ -57,6 +62,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	return types.NewGenesisState(
 		params,
+		keeper.GetMilestoneParams(ctx),
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
@@ -66,6 +66,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	params := types.DefaultParams()
 	genesisState := types.NewGenesisState(
 		params,
+		types.DefaultMilestoneParams(),
 		&bufferedCheckpoint,
 		uint64(lastNoACK),
 		uint64(ackCount),
@@ -15,7 +15,7 @@ import (
 // handleMsgMilestone validates milestone transaction
 func handleMsgMilestone(ctx sdk.Context, msg types.MsgMilestone, k Keeper) sdk.Result {
 	logger := k.MilestoneLogger(ctx)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := k.GetMilestoneParams(ctx).MinMilestoneLength
 
 	//
 	//Get milestone validator set
@@ -92,6 +92,10 @@ func handleMsgMilestone(ctx sdk.Context, msg types.MsgMilestone, k Keeper) sdk.R
 
 	k.SetMilestoneBlockNumber(ctx, ctx.BlockHeight())
 
+	k.UpdateMilestoneStats(ctx, msg.Proposer, func(stats *types.MilestoneStats) {
+		stats.Proposed++
+	})
+
 	//Set the MilestoneID in the cache
 	types.SetMilestoneID(msg.MilestoneID)
 
@@ -120,7 +124,7 @@ func handleMsgMilestoneTimeout(ctx sdk.Context, _ types.MsgMilestoneTimeout, k K
 	currentTime := ctx.BlockTime()
 
 	// Get buffer time from params
-	bufferTime := helper.MilestoneBufferTime
+	bufferTime := k.GetMilestoneParams(ctx).MilestoneBufferTime
 
 	// Fetch last checkpoint from store
 	// TODO figure out how to handle this error
@@ -162,6 +166,14 @@ func handleMsgMilestoneTimeout(ctx sdk.Context, _ types.MsgMilestoneTimeout, k K
 	// Update to new proposer
 	//
 
+	// Count the timeout against the proposer who didn't get a milestone in
+	timedOutSet := k.sk.GetMilestoneValidatorSet(ctx)
+	if proposer := timedOutSet.GetProposer(); proposer != nil {
+		k.UpdateMilestoneStats(ctx, proposer.Signer, func(stats *types.MilestoneStats) {
+			stats.TimedOut++
+		})
+	}
+
 	// Increment accum (selects new proposer)
 	k.sk.MilestoneIncrementAccum(ctx, 1)
 
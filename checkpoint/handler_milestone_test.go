@@ -337,7 +337,13 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneTimeout() {
 		proposer,
 	)
 
+	milestoneValidatorSet := stakingKeeper.GetMilestoneValidatorSet(ctx)
+	timedOutProposer := milestoneValidatorSet.GetProposer().Signer
+
 	// send milestone to handler
 	got = suite.handler(suite.ctx, msgMilestoneTimeout)
 	require.True(t, got.IsOK(), errs.CodeToDefaultMsg(got.Code))
+
+	// the timeout is counted against the proposer who missed the milestone
+	require.Equal(t, uint64(1), keeper.GetMilestoneStats(ctx, timedOutProposer).TimedOut)
 }
@@ -27,7 +27,7 @@ func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLICo
 
 	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
 
-	params := types.NewParams(5*time.Second, 256, 1024, 10000)
+	params := types.NewParams(5*time.Second, 256, 1024, 10000, 100, false)
 
 	Checkpoints := make([]hmTypes.Checkpoint, 0)
 
@@ -37,6 +37,7 @@ func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLICo
 
 	checkpointGenesis := types.NewGenesisState(
 		types.DefaultGenesisState().Params,
+		types.DefaultGenesisState().MilestoneParams,
 		types.DefaultGenesisState().BufferedCheckpoint,
 		types.DefaultGenesisState().LastNoACK,
 		types.DefaultGenesisState().AckCount,
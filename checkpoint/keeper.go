@@ -45,6 +45,9 @@ type Keeper struct {
 
 	// module communicator
 	moduleCommunicator ModuleCommunicator
+
+	// node-local milestone stats metrics before the milestone history hardfork
+	milestoneStatsIndex *milestoneStatsIndex
 }
 
 // NewKeeper create new keeper
@@ -65,6 +68,8 @@ func NewKeeper(
 		sk:                 stakingKeeper,
 		ck:                 chainKeeper,
 		moduleCommunicator: moduleCommunicator,
+
+		milestoneStatsIndex: newMilestoneStatsIndex(),
 	}
 
 	return keeper
@@ -163,6 +168,33 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 	return checkpoints, nil
 }
 
+// GetCheckpointByBorBlock returns the acknowledged checkpoint covering the bor
+// block, along with its checkpoint number. Checkpoints cover consecutive block
+// ranges, so they are searched by number.
+func (k *Keeper) GetCheckpointByBorBlock(ctx sdk.Context, blockNumber uint64) (uint64, hmTypes.Checkpoint, error) {
+	low, high := uint64(1), k.GetACKCount(ctx)
+
+	for low <= high {
+		number := low + (high-low)/2
+
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			return 0, checkpoint, err
+		}
+
+		switch {
+		case blockNumber < checkpoint.StartBlock:
+			high = number - 1
+		case blockNumber > checkpoint.EndBlock:
+			low = number + 1
+		default:
+			return number, checkpoint, nil
+		}
+	}
+
+	return 0, hmTypes.Checkpoint{}, cmn.ErrNoCheckpointFound(k.Codespace())
+}
+
 // GetLastCheckpoint gets last checkpoint, checkpoint number = TotalACKs
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -322,8 +354,25 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. Parameters added after
+// genesis keep their default values until they are set.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+
+	return
+}
+
+// SetMilestoneParams sets the milestone parameters.
+func (k Keeper) SetMilestoneParams(ctx sdk.Context, params types.MilestoneParams) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMilestoneParams gets the milestone parameters, the defaults until they
+// are set through genesis or a parameter change proposal.
+func (k Keeper) GetMilestoneParams(ctx sdk.Context) (params types.MilestoneParams) {
+	params = types.DefaultMilestoneParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+
 	return
 }
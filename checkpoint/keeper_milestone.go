@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -18,6 +19,9 @@ var (
 	MilestoneLastNoAckKey = []byte{0x50} //Key to store the Latest NoAckMilestone
 	LastMilestoneTimeout  = []byte{0x60} //Key to store the Last Milestone Timeout
 	BlockNumberKey        = []byte{0x70} //Key to store the count
+	MilestoneIDKey        = []byte{0x80} //Key to index the milestone number by milestone id
+	OldestMilestoneKey    = []byte{0x90} //Key to store the number of the oldest milestone kept
+	MilestoneStatsKey     = []byte{0xa0} //Key to store the milestone stats of the proposers
 )
 
 // Logger returns a module-specific logger
@@ -34,9 +38,14 @@ func (k *Keeper) AddMilestone(ctx sdk.Context, milestone hmTypes.Milestone) erro
 		return err
 	}
 
-	pruningNumber := milestoneNumber - helper.MilestonePruneNumber
+	if ctx.BlockHeight() >= helper.GetMilestoneHistoryHeight() {
+		ctx.KVStore(k.storeKey).Set(GetMilestoneIDKey(milestone.MilestoneID), []byte(strconv.FormatUint(milestoneNumber, 10)))
+
+		k.pruneMilestones(ctx, milestoneNumber) //Prune the old milestones to reduce the memory consumption
+	} else {
+		k.PruneMilestone(ctx, milestoneNumber-helper.MilestonePruneNumber) //Prune the old milestone to reduce the memory consumption
+	}
 
-	k.PruneMilestone(ctx, pruningNumber) //Prune the old milestone to reduce the memory consumption
 	k.SetMilestoneCount(ctx, milestoneNumber)
 	k.Logger(ctx).Info("Adding good milestone to state", "milestone", milestone, "milestoneNumber", milestoneNumber)
 
@@ -84,6 +93,82 @@ func (k *Keeper) GetMilestoneByNumber(ctx sdk.Context, number uint64) (*hmTypes.
 	return nil, cmn.ErrInvalidMilestoneIndex(k.Codespace())
 }
 
+// GetMilestoneByID returns the milestone with the milestone id, along with its milestone number
+func (k *Keeper) GetMilestoneByID(ctx sdk.Context, milestoneID string) (uint64, *hmTypes.Milestone, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetMilestoneIDKey(milestoneID))
+	if bz == nil {
+		return 0, nil, cmn.ErrNoMilestoneFound(k.Codespace())
+	}
+
+	number, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	milestone, err := k.GetMilestoneByNumber(ctx, number)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return number, milestone, nil
+}
+
+// GetMilestoneByBorBlock returns the milestone covering the bor block, along
+// with its milestone number. Milestones cover increasing block ranges, so the
+// kept ones are searched by number.
+func (k *Keeper) GetMilestoneByBorBlock(ctx sdk.Context, blockNumber uint64) (uint64, *hmTypes.Milestone, error) {
+	low, high := k.GetOldestMilestoneNumber(ctx), k.GetMilestoneCount(ctx)
+
+	for low <= high {
+		number := low + (high-low)/2
+
+		milestone, err := k.GetMilestoneByNumber(ctx, number)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		switch {
+		case blockNumber < milestone.StartBlock:
+			high = number - 1
+		case blockNumber > milestone.EndBlock:
+			low = number + 1
+		default:
+			return number, milestone, nil
+		}
+	}
+
+	return 0, nil, cmn.ErrNoMilestoneFound(k.Codespace())
+}
+
+// GetMilestoneList returns the page of the kept milestones, from the oldest one
+func (k *Keeper) GetMilestoneList(ctx sdk.Context, page uint64, limit uint64) ([]types.MilestoneRecord, error) {
+	// have max limit
+	if limit > 20 {
+		limit = 20
+	}
+
+	if page == 0 || limit == 0 {
+		return nil, nil
+	}
+
+	count := k.GetMilestoneCount(ctx)
+
+	var milestones []types.MilestoneRecord
+
+	for number := k.GetOldestMilestoneNumber(ctx) + (page-1)*limit; number <= count && uint64(len(milestones)) < limit; number++ {
+		milestone, err := k.GetMilestoneByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+
+		milestones = append(milestones, types.NewMilestoneRecord(number, *milestone))
+	}
+
+	return milestones, nil
+}
+
 // GetLastMilestone gets last milestone, milestone number = GetCount()
 func (k *Keeper) GetLastMilestone(ctx sdk.Context) (*hmTypes.Milestone, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -169,9 +254,78 @@ func (k *Keeper) PruneMilestone(ctx sdk.Context, number uint64) {
 		return
 	}
 
+	if ctx.BlockHeight() >= helper.GetMilestoneHistoryHeight() {
+		var milestone hmTypes.Milestone
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(milestoneKey), &milestone); err == nil {
+			store.Delete(GetMilestoneIDKey(milestone.MilestoneID))
+		}
+	}
+
 	store.Delete(milestoneKey)
 }
 
+// pruneMilestones removes the milestones out of the retention window once the
+// milestone is added, unless the history is archived. At most
+// MilestonePruneLimit milestones are removed at once, a shorter retention is
+// reached over the next milestones.
+func (k *Keeper) pruneMilestones(ctx sdk.Context, milestoneNumber uint64) {
+	params := k.GetParams(ctx)
+	oldest := k.GetOldestMilestoneNumber(ctx)
+
+	if !params.MilestoneArchive {
+		retention := params.GetMilestoneRetention()
+
+		for pruned := uint64(0); oldest+retention <= milestoneNumber && pruned < helper.MilestonePruneLimit; pruned++ {
+			k.PruneMilestone(ctx, oldest)
+			oldest++
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(OldestMilestoneKey, []byte(strconv.FormatUint(oldest, 10)))
+}
+
+// IndexMilestoneHistory indexes the milestones kept before the milestone
+// history hardfork by their milestone id, and stores the oldest of them. It runs
+// once, at the hardfork height.
+func (k *Keeper) IndexMilestoneHistory(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	oldest, count := k.GetOldestMilestoneNumber(ctx), k.GetMilestoneCount(ctx)
+
+	for number := oldest; number <= count; number++ {
+		milestone, err := k.GetMilestoneByNumber(ctx, number)
+		if err != nil {
+			k.MilestoneLogger(ctx).Error("Unable to index milestone", "number", number, "error", err)
+			continue
+		}
+
+		store.Set(GetMilestoneIDKey(milestone.MilestoneID), []byte(strconv.FormatUint(number, 10)))
+	}
+
+	store.Set(OldestMilestoneKey, []byte(strconv.FormatUint(oldest, 10)))
+
+	k.MilestoneLogger(ctx).Info("Indexed milestone history", "oldest", oldest, "count", count)
+}
+
+// GetOldestMilestoneNumber returns the number of the oldest milestone kept in the store
+func (k *Keeper) GetOldestMilestoneNumber(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(OldestMilestoneKey) {
+		result, err := strconv.ParseUint(string(store.Get(OldestMilestoneKey)), 10, 64)
+		if err == nil {
+			return result
+		}
+	}
+
+	// milestones used to be pruned a fixed number behind the count
+	if count := k.GetMilestoneCount(ctx); count > helper.MilestonePruneNumber {
+		return count - helper.MilestonePruneNumber + 1
+	}
+
+	return 1
+}
+
 // SetLastNoAck set last no-ack object
 func (k *Keeper) SetNoAckMilestone(ctx sdk.Context, milestoneId string) {
 	store := ctx.KVStore(k.storeKey)
@@ -228,6 +382,81 @@ func (k *Keeper) GetLastMilestoneTimeout(ctx sdk.Context) uint64 {
 	return 0
 }
 
+// GetMilestoneStats returns the milestone stats of the proposer
+func (k *Keeper) GetMilestoneStats(ctx sdk.Context, proposer hmTypes.HeimdallAddress) types.MilestoneStats {
+	store := ctx.KVStore(k.storeKey)
+
+	stats := types.NewMilestoneStats(proposer)
+
+	if bz := store.Get(GetMilestoneStatsKey(proposer)); bz != nil {
+		if err := k.cdc.UnmarshalBinaryBare(bz, &stats); err != nil {
+			k.MilestoneLogger(ctx).Error("Unable to fetch milestone stats from store", "proposer", proposer, "error", err)
+			return types.NewMilestoneStats(proposer)
+		}
+	}
+
+	return stats
+}
+
+// GetAllMilestoneStats returns the milestone stats of all the proposers
+func (k *Keeper) GetAllMilestoneStats(ctx sdk.Context) []types.MilestoneStats {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, MilestoneStatsKey)
+	defer iterator.Close()
+
+	var allStats []types.MilestoneStats
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.MilestoneStats
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &stats); err == nil {
+			allStats = append(allStats, stats)
+		}
+	}
+
+	return allStats
+}
+
+// UpdateMilestoneStats applies the update to the milestone stats of the
+// proposer. They are kept in state from the milestone history hardfork. Before
+// it they are only exported as metrics, counted by this node since it started.
+func (k *Keeper) UpdateMilestoneStats(ctx sdk.Context, proposer hmTypes.HeimdallAddress, update func(stats *types.MilestoneStats)) {
+	if ctx.BlockHeight() < helper.GetMilestoneHistoryHeight() {
+		if !ctx.IsCheckTx() {
+			setMilestoneStatsMetrics(k.milestoneStatsIndex.update(proposer, update))
+		}
+
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	stats := k.GetMilestoneStats(ctx, proposer)
+	update(&stats)
+
+	out, err := k.cdc.MarshalBinaryBare(stats)
+	if err != nil {
+		k.MilestoneLogger(ctx).Error("Error marshalling milestone stats", "error", err)
+		return
+	}
+
+	store.Set(GetMilestoneStatsKey(proposer), out)
+
+	if !ctx.IsCheckTx() {
+		setMilestoneStatsMetrics(stats)
+	}
+}
+
+// GetMilestoneStatsKey appends prefix to the proposer address
+func GetMilestoneStatsKey(proposer hmTypes.HeimdallAddress) []byte {
+	return append(MilestoneStatsKey, proposer.Bytes()...)
+}
+
+// GetMilestoneIDKey appends prefix to milestoneID
+func GetMilestoneIDKey(milestoneID string) []byte {
+	return append(MilestoneIDKey, []byte(milestoneID)...)
+}
+
 // GetMilestoneKey appends prefix to milestoneNumber
 func GetMilestoneNoAckKey(milestoneId string) []byte {
 	milestoneNoAckBytes := []byte(milestoneId)
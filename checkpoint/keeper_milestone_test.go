@@ -1,10 +1,14 @@
 package checkpoint_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/maticnetwork/heimdall/checkpoint"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
 	"github.com/stretchr/testify/require"
@@ -98,6 +102,173 @@ func (suite *KeeperTestSuite) TestPruneMilestone() {
 	require.Equal(t, err, cmn.ErrInvalidMilestoneIndex(keeper.Codespace()))
 }
 
+func (suite *KeeperTestSuite) TestMilestoneHistory() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.MilestoneRetention = 3
+	keeper.SetParams(ctx, params)
+
+	addMilestones := func(from, to uint64) {
+		for number := from; number <= to; number++ {
+			milestone := hmTypes.CreateMilestone(
+				(number-1)*64,
+				number*64-1,
+				hmTypes.HexToHeimdallHash("123"),
+				hmTypes.HexToHeimdallAddress("123"),
+				"1234",
+				fmt.Sprintf("%04d", number),
+				uint64(time.Now().Unix()),
+			)
+			require.NoError(t, keeper.AddMilestone(ctx, milestone))
+		}
+	}
+
+	// only the latest milestones of the retention window are kept
+	addMilestones(1, 5)
+	require.Equal(t, uint64(3), keeper.GetOldestMilestoneNumber(ctx))
+
+	_, err := keeper.GetMilestoneByNumber(ctx, 2)
+	require.Error(t, err)
+
+	_, _, err = keeper.GetMilestoneByID(ctx, "0002")
+	require.Error(t, err)
+
+	number, milestone, err := keeper.GetMilestoneByID(ctx, "0004")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), number)
+	require.Equal(t, "0004", milestone.MilestoneID)
+
+	for _, blockNumber := range []uint64{128, 200, 319} {
+		number, milestone, err = keeper.GetMilestoneByBorBlock(ctx, blockNumber)
+		require.NoError(t, err)
+		require.Equal(t, blockNumber/64+1, number)
+		require.Equal(t, fmt.Sprintf("%04d", number), milestone.MilestoneID)
+	}
+
+	// pruned and future blocks are not covered
+	_, _, err = keeper.GetMilestoneByBorBlock(ctx, 127)
+	require.Error(t, err)
+	_, _, err = keeper.GetMilestoneByBorBlock(ctx, 320)
+	require.Error(t, err)
+
+	list, err := keeper.GetMilestoneList(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, uint64(3), list[0].Number)
+	require.Equal(t, uint64(4), list[1].Number)
+
+	list, err = keeper.GetMilestoneList(ctx, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, uint64(5), list[0].Number)
+
+	// archive mode never prunes
+	params.MilestoneArchive = true
+	keeper.SetParams(ctx, params)
+
+	addMilestones(6, 8)
+	require.Equal(t, uint64(3), keeper.GetOldestMilestoneNumber(ctx))
+
+	list, err = keeper.GetMilestoneList(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 6)
+
+	// leaving archive mode prunes down to the retention window again
+	params.MilestoneArchive = false
+	params.MilestoneRetention = 2
+	keeper.SetParams(ctx, params)
+
+	addMilestones(9, 9)
+	require.Equal(t, uint64(8), keeper.GetOldestMilestoneNumber(ctx))
+
+	for number := uint64(3); number < 8; number++ {
+		_, err = keeper.GetMilestoneByNumber(ctx, number)
+		require.Error(t, err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMilestoneHistoryPruneLimit() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.MilestoneArchive = true
+	keeper.SetParams(ctx, params)
+
+	addMilestone := func(number uint64) {
+		milestone := hmTypes.CreateMilestone(
+			(number-1)*64,
+			number*64-1,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			fmt.Sprintf("%04d", number),
+			uint64(time.Now().Unix()),
+		)
+		require.NoError(t, keeper.AddMilestone(ctx, milestone))
+	}
+
+	archived := helper.MilestonePruneLimit + 10
+	for number := uint64(1); number <= archived; number++ {
+		addMilestone(number)
+	}
+
+	require.Equal(t, uint64(1), keeper.GetOldestMilestoneNumber(ctx))
+
+	// the archive is pruned down over the next milestones
+	params.MilestoneArchive = false
+	params.MilestoneRetention = 1
+	keeper.SetParams(ctx, params)
+
+	addMilestone(archived + 1)
+	require.Equal(t, helper.MilestonePruneLimit+1, keeper.GetOldestMilestoneNumber(ctx))
+
+	addMilestone(archived + 2)
+	require.Equal(t, archived+2, keeper.GetOldestMilestoneNumber(ctx))
+}
+
+func (suite *KeeperTestSuite) TestIndexMilestoneHistory() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	for number := uint64(1); number <= 3; number++ {
+		milestone := hmTypes.CreateMilestone(
+			(number-1)*64,
+			number*64-1,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			fmt.Sprintf("%04d", number),
+			uint64(time.Now().Unix()),
+		)
+		require.NoError(t, keeper.AddMilestone(ctx, milestone))
+	}
+
+	// milestones added before the hardfork are not indexed
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for number := uint64(1); number <= 3; number++ {
+		store.Delete(checkpoint.GetMilestoneIDKey(fmt.Sprintf("%04d", number)))
+	}
+
+	store.Delete(checkpoint.OldestMilestoneKey)
+
+	_, _, err := keeper.GetMilestoneByID(ctx, "0002")
+	require.Error(t, err)
+
+	keeper.IndexMilestoneHistory(ctx)
+
+	for number := uint64(1); number <= 3; number++ {
+		indexed, milestone, err := keeper.GetMilestoneByID(ctx, fmt.Sprintf("%04d", number))
+		require.NoError(t, err)
+		require.Equal(t, number, indexed)
+		require.Equal(t, fmt.Sprintf("%04d", number), milestone.MilestoneID)
+	}
+
+	require.Equal(t, uint64(1), keeper.GetOldestMilestoneNumber(ctx))
+}
+
 func (suite *KeeperTestSuite) TestGetCount() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -196,3 +367,39 @@ func (suite *KeeperTestSuite) TestGetMilestoneTimout() {
 	val = keeper.GetLastMilestoneTimeout(ctx)
 	require.Equal(t, uint64(21), val)
 }
+
+func (suite *KeeperTestSuite) TestMilestoneParams() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	checkpointParams := keeper.GetParams(ctx)
+
+	params := keeper.GetMilestoneParams(ctx)
+	require.Equal(t, types.DefaultMilestoneParams(), params)
+
+	params.MinMilestoneLength = 32
+	params.MilestoneBufferTime = 2 * time.Minute
+	params.MilestoneTimeoutInterval = 128
+	keeper.SetMilestoneParams(ctx, params)
+
+	require.Equal(t, params, keeper.GetMilestoneParams(ctx))
+	require.Equal(t, checkpointParams, keeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMilestoneParamsChange() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	// unset params are validated with their defaults
+	require.NoError(t, paramSpace.Validate(ctx, types.KeyMinMilestoneLength))
+
+	require.NoError(t, paramSpace.Update(ctx, types.KeyMinMilestoneLength, []byte(`"64"`)))
+	require.NoError(t, paramSpace.Update(ctx, types.KeyMilestoneTimeoutInterval, []byte(`"32"`)))
+	require.Error(t, paramSpace.Validate(ctx, types.KeyMilestoneTimeoutInterval), "MilestoneTimeoutInterval less than MinMilestoneLength should be rejected")
+
+	require.NoError(t, paramSpace.Update(ctx, types.KeyMilestoneTimeoutInterval, []byte(`"128"`)))
+	require.NoError(t, paramSpace.Validate(ctx, types.KeyMinMilestoneLength))
+
+	// checkpoint params are not validated on changes
+	require.NoError(t, paramSpace.Validate(ctx, types.KeyChildBlockInterval))
+}
@@ -0,0 +1,59 @@
+package checkpoint
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+var (
+	milestonesProposedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "heimdall",
+		Subsystem: "milestone",
+		Name:      "proposed",
+		Help:      "The total number of milestones proposed by the proposer",
+	}, []string{"proposer"})
+
+	milestonesAcceptedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "heimdall",
+		Subsystem: "milestone",
+		Name:      "accepted",
+		Help:      "The total number of milestones of the proposer added to the state",
+	}, []string{"proposer"})
+
+	milestonesTimedOutGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "heimdall",
+		Subsystem: "milestone",
+		Name:      "timed_out",
+		Help:      "The total number of milestone timeouts while the proposer was selected",
+	}, []string{"proposer"})
+
+	milestonesNoAckedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "heimdall",
+		Subsystem: "milestone",
+		Name:      "no_acked",
+		Help:      "The total number of milestones of the proposer rejected as no-ack",
+	}, []string{"proposer"})
+
+	milestoneAvgLengthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "heimdall",
+		Subsystem: "milestone",
+		Name:      "avg_length",
+		Help:      "The average length in bor blocks of the accepted milestones of the proposer",
+	}, []string{"proposer"})
+)
+
+// setMilestoneStatsMetrics exports the milestone statistics of the proposer.
+// The gauges are set to the totals in state, so replayed blocks do not count twice.
+// Before the milestone history hardfork they are node-local totals, counted since
+// the node started.
+func setMilestoneStatsMetrics(stats types.MilestoneStats) {
+	proposer := stats.Proposer.String()
+
+	milestonesProposedGauge.WithLabelValues(proposer).Set(float64(stats.Proposed))
+	milestonesAcceptedGauge.WithLabelValues(proposer).Set(float64(stats.Accepted))
+	milestonesTimedOutGauge.WithLabelValues(proposer).Set(float64(stats.TimedOut))
+	milestonesNoAckedGauge.WithLabelValues(proposer).Set(float64(stats.NoAcked))
+	milestoneAvgLengthGauge.WithLabelValues(proposer).Set(float64(stats.AvgLength))
+}
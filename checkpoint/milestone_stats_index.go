@@ -0,0 +1,45 @@
+package checkpoint
+
+import (
+	"sync"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// milestoneStatsIndex holds the milestone stats counted by this node out of
+// the state, from the milestone txs it delivers, to export them as metrics
+// before the milestone history hardfork. They are node-local: they start
+// empty on every restart and are never served by the queries.
+type milestoneStatsIndex struct {
+	mu    sync.Mutex
+	stats map[string]types.MilestoneStats
+}
+
+func newMilestoneStatsIndex() *milestoneStatsIndex {
+	return &milestoneStatsIndex{stats: make(map[string]types.MilestoneStats)}
+}
+
+// update applies the update to the milestone stats of the proposer and
+// returns them
+func (i *milestoneStatsIndex) update(proposer hmTypes.HeimdallAddress, update func(stats *types.MilestoneStats)) types.MilestoneStats {
+	if i == nil {
+		stats := types.NewMilestoneStats(proposer)
+		update(&stats)
+
+		return stats
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	stats, ok := i.stats[proposer.String()]
+	if !ok {
+		stats = types.NewMilestoneStats(proposer)
+	}
+
+	update(&stats)
+	i.stats[proposer.String()] = stats
+
+	return stats
+}
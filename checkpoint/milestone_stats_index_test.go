@@ -0,0 +1,38 @@
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestMilestoneStatsIndex(t *testing.T) {
+	t.Parallel()
+
+	index := newMilestoneStatsIndex()
+
+	proposer1 := hmTypes.HexToHeimdallAddress("0x02")
+	proposer2 := hmTypes.HexToHeimdallAddress("0x01")
+
+	index.update(proposer1, func(stats *types.MilestoneStats) {
+		stats.Proposed++
+	})
+
+	stats := index.update(proposer1, func(stats *types.MilestoneStats) {
+		stats.AddAccepted(64)
+	})
+	require.Equal(t, proposer1, stats.Proposer)
+	require.Equal(t, uint64(1), stats.Proposed)
+	require.Equal(t, uint64(1), stats.Accepted)
+	require.Equal(t, uint64(64), stats.AvgLength)
+
+	stats = index.update(proposer2, func(stats *types.MilestoneStats) {
+		stats.TimedOut++
+	})
+	require.Equal(t, proposer2, stats.Proposer)
+	require.Equal(t, uint64(1), stats.TimedOut)
+	require.Zero(t, stats.Proposed, "Stats are counted per proposer")
+}
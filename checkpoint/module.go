@@ -167,7 +167,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 }
 
 // BeginBlock returns the begin blocker for the auth module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	// index the milestones kept before the milestone history hardfork
+	if ctx.BlockHeight() == helper.GetMilestoneHistoryHeight() {
+		am.keeper.IndexMilestoneHistory(ctx)
+	}
+}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
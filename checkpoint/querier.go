@@ -1,9 +1,12 @@
 package checkpoint
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	jsoniter "github.com/json-iterator/go"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -33,6 +36,10 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryCheckpointList(ctx, req, keeper)
 		case types.QueryNextCheckpoint:
 			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
+		case types.QueryExitProof:
+			return handleQueryExitProof(ctx, req, keeper, contractCaller)
+		case types.QueryBorBlockStatus:
+			return handleQueryBorBlockStatus(ctx, req, keeper)
 
 		case types.QueryCount:
 			return handleQueryCount(ctx, keeper)
@@ -44,6 +51,16 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryLatestNoAckMilestone(ctx, keeper)
 		case types.QueryNoAckMilestoneByID:
 			return handleQueryNoAckMilestoneByID(ctx, req, keeper)
+		case types.QueryMilestoneList:
+			return handleQueryMilestoneList(ctx, req, keeper)
+		case types.QueryMilestoneByID:
+			return handleQueryMilestoneByID(ctx, req, keeper)
+		case types.QueryMilestoneByBorBlock:
+			return handleQueryMilestoneByBorBlock(ctx, req, keeper)
+		case types.QueryMilestoneStats:
+			return handleQueryMilestoneStats(ctx, req, keeper)
+		case types.QueryMilestoneParameters:
+			return handleQueryMilestoneParameters(ctx, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
@@ -193,3 +210,136 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 
 	return bz, nil
 }
+
+func handleQueryBorBlockStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res := types.BorBlockStatus{BlockNumber: params.BlockNumber}
+
+	// checkpoints cover the bor chain up to the end block of the last one
+	if lastCheckpoint, err := keeper.GetLastCheckpoint(ctx); err == nil && params.BlockNumber <= lastCheckpoint.EndBlock {
+		number, checkpoint, err := keeper.GetCheckpointByBorBlock(ctx, params.BlockNumber)
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not find checkpoint of block %v", params.BlockNumber), err.Error()))
+		}
+
+		res.Checkpointed = true
+		res.CheckpointNumber = number
+		res.Checkpoint = &checkpoint
+	}
+
+	// and milestones finalize it up to the end block of the last one
+	if lastMilestone, err := keeper.GetLastMilestone(ctx); err == nil && params.BlockNumber <= lastMilestone.EndBlock {
+		res.MilestoneCovered = true
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryExitProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryExitProofParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	var (
+		blockNumber = params.BlockNumber
+		receipt     *ethTypes.Receipt
+		err         error
+	)
+
+	// the block of the tx
+	if params.TxHash != "" {
+		if receipt, err = contractCaller.GetMaticTxReceipt(ethCommon.HexToHash(params.TxHash)); err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch receipt of tx %v", params.TxHash), err.Error()))
+		}
+
+		if params.LogIndex >= uint64(len(receipt.Logs)) {
+			return nil, sdk.ErrInternal(fmt.Sprintf("tx %v has no log with index %v", params.TxHash, params.LogIndex))
+		}
+
+		blockNumber = receipt.BlockNumber.Uint64()
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByBorBlock(ctx, blockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not find checkpoint of block %v", blockNumber), err.Error()))
+	}
+
+	headers, err := contractCaller.GetMaticChainBlockHeaders(checkpoint.StartBlock, checkpoint.EndBlock)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch headers for start:%v end:%v", checkpoint.StartBlock, checkpoint.EndBlock), err.Error()))
+	}
+
+	index := int(blockNumber - checkpoint.StartBlock)
+
+	blockProof, rootHash, err := types.GetBlockProof(headers, index)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not build proof of block %v", blockNumber), err.Error()))
+	}
+
+	if !bytes.Equal(rootHash, checkpoint.RootHash.Bytes()) {
+		return nil, sdk.ErrInternal(fmt.Sprintf("root hash of bor headers does not match checkpoint %v", number))
+	}
+
+	header := headers[index]
+	proof := types.ExitProof{
+		CheckpointNumber:  number,
+		HeaderBlockNumber: number * keeper.GetParams(ctx).ChildBlockInterval,
+		StartBlock:        checkpoint.StartBlock,
+		EndBlock:          checkpoint.EndBlock,
+		RootHash:          checkpoint.RootHash,
+		BlockNumber:       blockNumber,
+		BlockTime:         header.Time,
+		TxRoot:            header.TxHash,
+		ReceiptRoot:       header.ReceiptHash,
+		BlockProof:        blockProof,
+	}
+
+	if receipt != nil {
+		receipts, err := contractCaller.GetMaticBlockReceipts(blockNumber)
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch receipts of block %v", blockNumber), err.Error()))
+		}
+
+		receiptProof, receiptPath, err := types.GetReceiptProof(receipts, receipt.TransactionIndex, header.ReceiptHash)
+		if err != nil && len(receipts) > 1 {
+			// bor lists the state sync tx of the block last, its receipt is not part of the receipts root
+			receiptProof, receiptPath, err = types.GetReceiptProof(receipts[:len(receipts)-1], receipt.TransactionIndex, header.ReceiptHash)
+		}
+
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not build receipt proof of tx %v", params.TxHash), err.Error()))
+		}
+
+		receiptBytes, err := receipt.MarshalBinary()
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not encode receipt", err.Error()))
+		}
+
+		proof.TxHash = &receipt.TxHash
+		proof.Receipt = receiptBytes
+		proof.ReceiptProof = receiptProof
+		proof.ReceiptPath = receiptPath
+		proof.LogIndex = params.LogIndex
+
+		if proof.Payload, err = types.EncodeExitPayload(&proof); err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not encode exit payload", err.Error()))
+		}
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(proof)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	jsoniter "github.com/json-iterator/go"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // handleQueryLatestMilestone to get the latest milestone
@@ -92,3 +95,105 @@ func handleQueryNoAckMilestoneByID(ctx sdk.Context, req abci.RequestQuery, keepe
 
 	return bz, nil
 }
+
+// handleQueryMilestoneList to get the page of the milestone history
+func handleQueryMilestoneList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params hmTypes.QueryPaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := keeper.GetMilestoneList(ctx, params.Page, params.Limit)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch milestone list with page %v and limit %v", params.Page, params.Limit), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// handleQueryMilestoneByID to get the milestone by milestone id
+func handleQueryMilestoneByID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var ID types.QueryMilestoneID
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &ID); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse milestoneID: %s", err))
+	}
+
+	// milestones are indexed by id from the milestone history hardfork
+	if ctx.BlockHeight() < helper.GetMilestoneHistoryHeight() {
+		return nil, sdk.ErrInternal("milestone history hardfork not activated yet")
+	}
+
+	number, milestone, err := keeper.GetMilestoneByID(ctx, ID.MilestoneID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch milestone", err.Error()))
+	}
+
+	bz, err := json.Marshal(types.NewMilestoneRecord(number, *milestone))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// handleQueryMilestoneByBorBlock to get the milestone covering the bor block
+func handleQueryMilestoneByBorBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	number, milestone, err := keeper.GetMilestoneByBorBlock(ctx, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch milestone of block %v", params.BlockNumber), err.Error()))
+	}
+
+	bz, err := json.Marshal(types.NewMilestoneRecord(number, *milestone))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// handleQueryMilestoneStats to get the milestone stats of a proposer, or of all the proposers
+func handleQueryMilestoneStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// milestone stats are kept in state from the milestone history hardfork
+	if ctx.BlockHeight() < helper.GetMilestoneHistoryHeight() {
+		return nil, sdk.ErrInternal("milestone history hardfork not activated yet")
+	}
+
+	var params types.QueryMilestoneStatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	var res []types.MilestoneStats
+	if params.Proposer.Empty() {
+		res = keeper.GetAllMilestoneStats(ctx)
+	} else {
+		res = []types.MilestoneStats{keeper.GetMilestoneStats(ctx, params.Proposer)}
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// handleQueryMilestoneParameters to get the milestone params
+func handleQueryMilestoneParameters(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := jsoniter.ConfigFastest.Marshal(keeper.GetMilestoneParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
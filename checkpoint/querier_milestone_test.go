@@ -183,3 +183,57 @@ func (suite *QuerierTestSuite) TestQueryNoAckMilestoneByID() {
 	require.NoError(t, err2)
 	require.Equal(t, val, true)
 }
+
+func (suite *QuerierTestSuite) TestQueryMilestoneHistory() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	for number := uint64(1); number <= 3; number++ {
+		milestone := hmTypes.CreateMilestone(
+			(number-1)*64,
+			number*64-1,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			fmt.Sprintf("%05d", number),
+			uint64(time.Now().Unix()),
+		)
+		require.NoError(t, app.CheckpointKeeper.AddMilestone(ctx, milestone))
+	}
+
+	query := func(path string, data []byte) ([]byte, error) {
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path),
+			Data: data,
+		}
+
+		return querier(ctx, []string{path}, req)
+	}
+
+	res, err := query(types.QueryMilestoneList, app.Codec().MustMarshalJSON(hmTypes.NewQueryPaginationParams(1, 10)))
+	require.NoError(t, err)
+
+	var list []types.MilestoneRecord
+	require.NoError(t, json.Unmarshal(res, &list))
+	require.Len(t, list, 3)
+	require.Equal(t, uint64(1), list[0].Number)
+	require.Equal(t, "00003", list[2].Milestone.MilestoneID)
+
+	res, err = query(types.QueryMilestoneByID, app.Codec().MustMarshalJSON(types.NewQueryMilestoneID("00002")))
+	require.NoError(t, err)
+
+	var record types.MilestoneRecord
+	require.NoError(t, json.Unmarshal(res, &record))
+	require.Equal(t, uint64(2), record.Number)
+	require.Equal(t, uint64(64), record.Milestone.StartBlock)
+
+	res, err = query(types.QueryMilestoneByBorBlock, app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(150)))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &record))
+	require.Equal(t, uint64(3), record.Number)
+
+	_, err = query(types.QueryMilestoneByID, app.Codec().MustMarshalJSON(types.NewQueryMilestoneID("00004")))
+	require.Error(t, err)
+
+	_, err = query(types.QueryMilestoneByBorBlock, app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(192)))
+	require.Error(t, err)
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -317,3 +319,148 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, actualRes.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, actualRes.BorChainID)
 }
+
+func (suite *QuerierTestSuite) TestQueryExitProof() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	startBlock := uint64(0)
+	endBlock := uint64(255)
+
+	receipts := ethTypes.Receipts{
+		{Status: ethTypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000, Logs: []*ethTypes.Log{{Data: []byte{1}}}},
+		{Status: ethTypes.ReceiptStatusSuccessful, CumulativeGasUsed: 42000, Logs: []*ethTypes.Log{{Data: []byte{2}}, {Data: []byte{3}}}},
+	}
+
+	headers := make([]*ethTypes.Header, endBlock-startBlock+1)
+	for i := range headers {
+		headers[i] = &ethTypes.Header{Number: big.NewInt(int64(i)), Time: uint64(1000 + i), ReceiptHash: ethTypes.EmptyReceiptsHash}
+	}
+
+	headers[10].ReceiptHash = types.GetReceiptsRoot(receipts)
+
+	_, rootHash, err := types.GetBlockProof(headers, 0)
+	require.NoError(t, err)
+
+	checkpointBlock := hmTypes.CreateBlock(
+		startBlock,
+		endBlock,
+		hmTypes.BytesToHeimdallHash(rootHash),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	err = app.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpointBlock)
+	require.NoError(t, err)
+	app.CheckpointKeeper.UpdateACKCount(ctx)
+
+	txHash := common.HexToHash("0x01")
+	receipt := *receipts[1]
+	receipt.TxHash = txHash
+	receipt.BlockNumber = big.NewInt(10)
+	receipt.TransactionIndex = 1
+
+	suite.contractCaller.On("GetMaticChainBlockHeaders", startBlock, endBlock).Return(headers, nil)
+	suite.contractCaller.On("GetMaticTxReceipt", txHash).Return(&receipt, nil)
+	suite.contractCaller.On("GetMaticBlockReceipts", uint64(10)).Return([]*ethTypes.Receipt(receipts), nil)
+
+	path := []string{types.QueryExitProof}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryExitProof)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryExitProofParams(0, txHash.Hex(), 1)),
+	}
+
+	res, sdkErr := querier(ctx, path, req)
+	require.NoError(t, sdkErr)
+
+	var proof types.ExitProof
+
+	err = jsoniter.ConfigFastest.Unmarshal(res, &proof)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proof.CheckpointNumber)
+	require.Equal(t, types.DefaultChildBlockInterval, proof.HeaderBlockNumber)
+	require.Equal(t, uint64(10), proof.BlockNumber)
+	require.True(t, types.VerifyBlockProof(headers[10], 10, proof.BlockProof, rootHash))
+	require.Equal(t, &txHash, proof.TxHash)
+	require.Equal(t, uint64(1), proof.LogIndex)
+	require.NotEmpty(t, proof.ReceiptProof)
+	require.NotEmpty(t, proof.Payload)
+
+	// blocks after the last checkpoint are not covered yet
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryExitProofParams(endBlock+1, "", 0))
+	_, sdkErr = querier(ctx, path, req)
+	require.Error(t, sdkErr)
+}
+
+func (suite *QuerierTestSuite) TestQueryBorBlockStatus() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	keeper := app.CheckpointKeeper
+
+	// checkpoints of the bor blocks 0-255, 256-511 and 512-767
+	checkpoints := make([]hmTypes.Checkpoint, 3)
+	for i := range checkpoints {
+		checkpoints[i] = hmTypes.CreateBlock(
+			uint64(i*256),
+			uint64(i*256+255),
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+
+		err := keeper.AddCheckpoint(ctx, uint64(i+1), checkpoints[i])
+		require.NoError(t, err)
+		keeper.UpdateACKCount(ctx)
+	}
+
+	// a milestone finalized the bor chain up to block 831
+	err := keeper.AddMilestone(ctx, hmTypes.CreateMilestone(
+		768,
+		831,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		"0000",
+		uint64(time.Now().Unix()),
+	))
+	require.NoError(t, err)
+
+	path := []string{types.QueryBorBlockStatus}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBorBlockStatus)
+
+	queryStatus := func(blockNumber uint64) types.BorBlockStatus {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(blockNumber)),
+		}
+		res, sdkErr := querier(ctx, path, req)
+		require.NoError(t, sdkErr)
+
+		var status types.BorBlockStatus
+		require.NoError(t, jsoniter.ConfigFastest.Unmarshal(res, &status))
+		require.Equal(t, blockNumber, status.BlockNumber)
+
+		return status
+	}
+
+	for _, blockNumber := range []uint64{0, 255, 256, 400, 767} {
+		status := queryStatus(blockNumber)
+		require.True(t, status.Checkpointed)
+		require.True(t, status.MilestoneCovered)
+		require.Equal(t, uint64(blockNumber/256+1), status.CheckpointNumber, "block %d", blockNumber)
+		require.Equal(t, checkpoints[blockNumber/256], *status.Checkpoint)
+	}
+
+	// only covered by the milestone
+	status := queryStatus(800)
+	require.False(t, status.Checkpointed)
+	require.Nil(t, status.Checkpoint)
+	require.True(t, status.MilestoneCovered)
+
+	// neither checkpointed nor finalized yet
+	status = queryStatus(832)
+	require.False(t, status.Checkpointed)
+	require.False(t, status.MilestoneCovered)
+}
@@ -17,7 +17,7 @@ import (
 // SideHandleMsgMilestone handles MsgMilestone message for external call
 func SideHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
 	// get params
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := k.GetMilestoneParams(ctx).MinMilestoneLength
 
 	// logger
 	logger := k.MilestoneLogger(ctx)
@@ -98,7 +98,7 @@ func PostHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, s
 	// Skip handler if milestone is not approved
 	if sideTxResult != abci.SideTxResultType_Yes {
 		logger.Debug("Skipping new milestone since side-tx didn't get yes votes", "startBlock", msg.StartBlock, "endBlock", msg.EndBlock, "hash", msg.Hash, "milestoneId", msg.MilestoneID)
-		k.SetNoAckMilestone(ctx, msg.MilestoneID)
+		noAckMilestone(ctx, k, msg)
 
 		return sdk.Result{
 			Events: ctx.EventManager().Events(),
@@ -114,7 +114,7 @@ func PostHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, s
 				"startBlock", msg.StartBlock,
 			)
 
-			k.SetNoAckMilestone(ctx, msg.MilestoneID)
+			noAckMilestone(ctx, k, msg)
 
 			return sdk.Result{
 				Events: ctx.EventManager().Events(),
@@ -127,7 +127,7 @@ func PostHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, s
 				"currentTip", lastMilestone.EndBlock,
 				"startBlock", msg.StartBlock)
 
-			k.SetNoAckMilestone(ctx, msg.MilestoneID)
+			noAckMilestone(ctx, k, msg)
 
 			return sdk.Result{
 				Events: ctx.EventManager().Events(),
@@ -136,7 +136,7 @@ func PostHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, s
 	} else if msg.StartBlock != helper.GetMilestoneBorBlockHeight() {
 		logger.Error("First milestone to start from", "block", helper.GetMilestoneBorBlockHeight(), "Error", err)
 
-		k.SetNoAckMilestone(ctx, msg.MilestoneID)
+		noAckMilestone(ctx, k, msg)
 
 		return sdk.Result{
 			Events: ctx.EventManager().Events(),
@@ -154,11 +154,40 @@ func PostHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, s
 		MilestoneID: msg.MilestoneID,
 		TimeStamp:   timeStamp,
 	}); err != nil {
-		k.SetNoAckMilestone(ctx, msg.MilestoneID)
+		noAckMilestone(ctx, k, msg)
 		logger.Error("Failed to set milestone ", "Error", err)
+
+		return sdk.Result{
+			Events: ctx.EventManager().Events(),
+		}
 	}
 
+	k.UpdateMilestoneStats(ctx, msg.Proposer, func(stats *types.MilestoneStats) {
+		stats.AddAccepted(msg.EndBlock - msg.StartBlock + 1)
+	})
+
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// noAckMilestone rejects the milestone, counting it against its proposer
+func noAckMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone) {
+	k.SetNoAckMilestone(ctx, msg.MilestoneID)
+
+	// the milestone event carries the vote, a milestone with yes votes may still be rejected
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMilestoneNoAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory), // module name
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyMilestoneID, msg.MilestoneID),
+		),
+	)
+
+	k.UpdateMilestoneStats(ctx, msg.Proposer, func(stats *types.MilestoneStats) {
+		stats.NoAcked++
+	})
+}
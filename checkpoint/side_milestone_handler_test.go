@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	cmTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
@@ -221,7 +222,11 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgMilestone() {
 			borChainId,
 			"00001",
 		)
-		_ = suite.postHandler(ctx, msgMilestone, abci.SideTxResultType_Yes)
+		result := suite.postHandler(ctx, msgMilestone, abci.SideTxResultType_Yes)
+
+		for _, event := range result.Events {
+			require.NotEqual(t, types.EventTypeMilestoneNoAck, event.Type, "Accepted milestone shouldn't be no-acked")
+		}
 
 		bufferedHeader, err := keeper.GetLastMilestone(ctx)
 		require.Equal(t, bufferedHeader.StartBlock, milestone.StartBlock)
@@ -252,7 +257,19 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgMilestone() {
 			borChainId,
 			"00002",
 		)
-		_ = suite.postHandler(ctx, msgMilestone, abci.SideTxResultType_Yes)
+		result := suite.postHandler(ctx, msgMilestone, abci.SideTxResultType_Yes)
+
+		// the milestone got yes votes, the no-ack has its own event
+		var noAckEvents []sdk.Event
+
+		for _, event := range result.Events {
+			if event.Type == types.EventTypeMilestoneNoAck {
+				noAckEvents = append(noAckEvents, event)
+			}
+		}
+
+		require.Len(t, noAckEvents, 1)
+		require.Contains(t, noAckEvents[0].Attributes, cmn.KVPair{Key: []byte(types.AttributeKeyMilestoneID), Value: []byte("00002")})
 
 		lastNoAckMilestone := keeper.GetLastNoAckMilestone(ctx)
 		require.Equal(t, lastNoAckMilestone, "00002")
@@ -296,4 +313,14 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgMilestone() {
 		lastNoAckMilestone := keeper.GetLastNoAckMilestone(ctx)
 		require.Equal(t, lastNoAckMilestone, "00004")
 	})
+
+	suite.Run("Stats", func() {
+		stats := keeper.GetMilestoneStats(ctx, milestone.Proposer)
+		require.Equal(t, milestone.Proposer, stats.Proposer)
+		require.Equal(t, uint64(1), stats.Accepted)
+		require.Equal(t, uint64(5), stats.NoAcked)
+		require.Equal(t, milestone.EndBlock-milestone.StartBlock+1, stats.AvgLength)
+
+		require.Equal(t, []types.MilestoneStats{stats}, keeper.GetAllMilestoneStats(ctx))
+	})
 }
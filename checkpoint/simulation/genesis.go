@@ -38,6 +38,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	params := types.DefaultParams()
 	genesisState := types.NewGenesisState(
 		params,
+		types.DefaultMilestoneParams(),
 		&bufferedCheckpoint,
 		uint64(lastNoACK),
 		uint64(ackCount),
@@ -18,6 +18,7 @@ var (
 
 	EventTypeMilestone        = "milestone"
 	EventTypeMilestoneTimeout = "milestone-timeout"
+	EventTypeMilestoneNoAck   = "milestone-noack"
 
 	AttributeKeyMilestoneID = "milestone-id"
 
@@ -10,7 +10,8 @@ import (
 
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
-	Params Params `json:"params" yaml:"params"`
+	Params          Params          `json:"params" yaml:"params"`
+	MilestoneParams MilestoneParams `json:"milestone_params" yaml:"milestone_params"`
 
 	BufferedCheckpoint *hmTypes.Checkpoint  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
 	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
@@ -21,6 +22,7 @@ type GenesisState struct {
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
+	milestoneParams MilestoneParams,
 	bufferedCheckpoint *hmTypes.Checkpoint,
 	lastNoACK uint64,
 	ackCount uint64,
@@ -28,6 +30,7 @@ func NewGenesisState(
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
+		MilestoneParams:    milestoneParams,
 		BufferedCheckpoint: bufferedCheckpoint,
 		LastNoACK:          lastNoACK,
 		AckCount:           ackCount,
@@ -38,7 +41,8 @@ func NewGenesisState(
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params: DefaultParams(),
+		Params:          DefaultParams(),
+		MilestoneParams: DefaultMilestoneParams(),
 	}
 }
 
@@ -49,6 +53,13 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	// genesis files from before the milestone params keep the defaults
+	if data.MilestoneParams != (MilestoneParams{}) {
+		if err := data.MilestoneParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if len(data.Checkpoints) != 0 {
 		if int(data.AckCount) != len(data.Checkpoints) {
 			return errors.New("Incorrect state in state-dump , Please Check")
@@ -0,0 +1,194 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ExitProof is the proof that a bor block, and optionally a tx receipt of
+// that block, is included in a checkpoint submitted to the root chain
+type ExitProof struct {
+	CheckpointNumber  uint64               `json:"checkpoint_number"`
+	HeaderBlockNumber uint64               `json:"header_block_number"`
+	StartBlock        uint64               `json:"start_block"`
+	EndBlock          uint64               `json:"end_block"`
+	RootHash          hmTypes.HeimdallHash `json:"root_hash"`
+
+	BlockNumber uint64        `json:"block_number"`
+	BlockTime   uint64        `json:"block_time"`
+	TxRoot      common.Hash   `json:"tx_root"`
+	ReceiptRoot common.Hash   `json:"receipt_root"`
+	BlockProof  hexutil.Bytes `json:"block_proof"`
+
+	// only set for the proof of a tx receipt
+	TxHash       *common.Hash  `json:"tx_hash,omitempty"`
+	Receipt      hexutil.Bytes `json:"receipt,omitempty"`
+	ReceiptProof hexutil.Bytes `json:"receipt_proof,omitempty"`
+	ReceiptPath  hexutil.Bytes `json:"receipt_path,omitempty"`
+	LogIndex     uint64        `json:"log_index"`
+
+	// rlp encoded exit payload, ready to be submitted to the root chain
+	Payload hexutil.Bytes `json:"payload,omitempty"`
+}
+
+// GetBlockHeaderLeaf returns the leaf of the block header in the checkpoint root hash tree
+func GetBlockHeaderLeaf(header *ethTypes.Header) []byte {
+	return crypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// GetBlockProof returns the merkle proof of the block header at index in the
+// checkpoint root hash tree of the headers, along with the root hash
+func GetBlockProof(headers []*ethTypes.Header, index int) ([]byte, []byte, error) {
+	if index < 0 || index >= len(headers) {
+		return nil, nil, errors.New("block not in the checkpoint headers")
+	}
+
+	// leaves are padded with empty hashes up to a power of two, like on bor
+	width := 1
+	for width < len(headers) {
+		width *= 2
+	}
+
+	level := make([][]byte, width)
+	for i := range level {
+		if i < len(headers) {
+			level[i] = GetBlockHeaderLeaf(headers[i])
+		} else {
+			level[i] = make([]byte, common.HashLength)
+		}
+	}
+
+	var proof []byte
+
+	for len(level) > 1 {
+		proof = append(proof, level[index^1]...)
+
+		parents := make([][]byte, len(level)/2)
+		for i := range parents {
+			parents[i] = crypto.Keccak256(level[2*i], level[2*i+1])
+		}
+
+		level = parents
+		index /= 2
+	}
+
+	return proof, level[0], nil
+}
+
+// GetReceiptsRoot returns the root hash of the receipts trie
+func GetReceiptsRoot(receipts ethTypes.Receipts) common.Hash {
+	return ethTypes.DeriveSha(receipts, newReceiptsTrie())
+}
+
+// proofList collects the trie nodes of a proof, in the order of the path
+type proofList []rlp.RawValue
+
+func (l *proofList) Put(_ []byte, value []byte) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func (l *proofList) Delete(_ []byte) error {
+	panic("not supported")
+}
+
+// newReceiptsTrie returns an empty in memory trie, to hash the receipts of a
+// block and to prove them
+func newReceiptsTrie() *trie.Trie {
+	return trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+}
+
+// GetReceiptProof returns the merkle patricia proof of the receipt at index in
+// the receipts trie of the block, along with the path of the receipt
+func GetReceiptProof(receipts ethTypes.Receipts, index uint, receiptRoot common.Hash) ([]byte, []byte, error) {
+	if index >= uint(len(receipts)) {
+		return nil, nil, errors.New("receipt not in the block receipts")
+	}
+
+	receiptsTrie := newReceiptsTrie()
+
+	if root := ethTypes.DeriveSha(receipts, receiptsTrie); root != receiptRoot {
+		return nil, nil, fmt.Errorf("receipts root mismatch, expected %v, got %v", receiptRoot, root)
+	}
+
+	key, err := rlp.EncodeToBytes(index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the nodes on the path to the receipt, from the root
+	var nodes proofList
+	if err := receiptsTrie.Prove(key, 0, &nodes); err != nil {
+		return nil, nil, err
+	}
+
+	proof, err := rlp.EncodeToBytes(nodes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the path is hex prefix encoded, with an even number of nibbles
+	return proof, append([]byte{0x00}, key...), nil
+}
+
+// EncodeExitPayload returns the rlp encoded exit payload of the exit proof,
+// as expected by the root chain predicates
+func EncodeExitPayload(proof *ExitProof) ([]byte, error) {
+	if proof.Receipt == nil {
+		return nil, errors.New("exit payload needs a tx receipt")
+	}
+
+	return rlp.EncodeToBytes([]interface{}{
+		proof.HeaderBlockNumber,
+		[]byte(proof.BlockProof),
+		proof.BlockNumber,
+		proof.BlockTime,
+		proof.TxRoot.Bytes(),
+		proof.ReceiptRoot.Bytes(),
+		[]byte(proof.Receipt),
+		[]byte(proof.ReceiptProof),
+		[]byte(proof.ReceiptPath),
+		proof.LogIndex,
+	})
+}
+
+// VerifyBlockProof checks the merkle proof of the block header against the
+// checkpoint root hash
+func VerifyBlockProof(header *ethTypes.Header, index int, proof []byte, rootHash []byte) bool {
+	if len(proof)%common.HashLength != 0 {
+		return false
+	}
+
+	hash := GetBlockHeaderLeaf(header)
+
+	for i := 0; i < len(proof); i += common.HashLength {
+		sibling := proof[i : i+common.HashLength]
+
+		if index%2 == 0 {
+			hash = crypto.Keccak256(hash, sibling)
+		} else {
+			hash = crypto.Keccak256(sibling, hash)
+		}
+
+		index /= 2
+	}
+
+	return bytes.Equal(hash, rootHash)
+}
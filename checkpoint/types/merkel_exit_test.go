@@ -0,0 +1,84 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReceiptProof(t *testing.T) {
+	t.Parallel()
+
+	receipts := make(ethTypes.Receipts, 150)
+	for i := range receipts {
+		receipts[i] = &ethTypes.Receipt{
+			Type:              ethTypes.DynamicFeeTxType,
+			Status:            ethTypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21000 * (i + 1)),
+			Logs:              []*ethTypes.Log{{Address: common.BigToAddress(big.NewInt(int64(i))), Data: []byte{byte(i)}}},
+		}
+	}
+
+	root := GetReceiptsRoot(receipts)
+
+	for _, index := range []uint{0, 1, 127, 128, 149} {
+		proof, path, err := GetReceiptProof(receipts, index, root)
+		require.NoError(t, err)
+
+		key, err := rlp.EncodeToBytes(index)
+		require.NoError(t, err)
+		require.Equal(t, append([]byte{0x00}, key...), path)
+
+		var nodes []rlp.RawValue
+		require.NoError(t, rlp.DecodeBytes(proof, &nodes))
+
+		proofDB := memorydb.New()
+		for _, node := range nodes {
+			require.NoError(t, proofDB.Put(crypto.Keccak256(node), node))
+		}
+
+		value, err := trie.VerifyProof(root, key, proofDB)
+		require.NoError(t, err)
+
+		receipt, err := receipts[index].MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, receipt, value)
+	}
+
+	_, _, err := GetReceiptProof(receipts, 0, common.Hash{})
+	require.Error(t, err)
+}
+
+func TestGetBlockProof(t *testing.T) {
+	t.Parallel()
+
+	headers := make([]*ethTypes.Header, 5)
+	for i := range headers {
+		headers[i] = &ethTypes.Header{
+			Number:      big.NewInt(int64(100 + i)),
+			Time:        uint64(1000 + i),
+			TxHash:      common.BigToHash(big.NewInt(int64(i))),
+			ReceiptHash: common.BigToHash(big.NewInt(int64(10 + i))),
+		}
+	}
+
+	for i := range headers {
+		proof, root, err := GetBlockProof(headers, i)
+		require.NoError(t, err)
+
+		// 5 leaves are padded to 8, so 3 levels
+		require.Len(t, proof, 3*common.HashLength)
+		require.True(t, VerifyBlockProof(headers[i], i, proof, root))
+		require.False(t, VerifyBlockProof(headers[(i+1)%len(headers)], i, proof, root))
+	}
+
+	_, _, err := GetBlockProof(headers, len(headers))
+	require.Error(t, err)
+}
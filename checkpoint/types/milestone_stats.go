@@ -0,0 +1,41 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// MilestoneStats are the milestone statistics of a proposer
+type MilestoneStats struct {
+	Proposer hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	Proposed uint64                  `json:"proposed" yaml:"proposed"`
+	Accepted uint64                  `json:"accepted" yaml:"accepted"`
+	TimedOut uint64                  `json:"timed_out" yaml:"timed_out"`
+	NoAcked  uint64                  `json:"no_acked" yaml:"no_acked"`
+
+	// bor blocks of the accepted milestones
+	TotalLength uint64 `json:"total_length" yaml:"total_length"`
+	AvgLength   uint64 `json:"avg_length" yaml:"avg_length"`
+}
+
+// NewMilestoneStats creates empty milestone statistics of the proposer
+func NewMilestoneStats(proposer hmTypes.HeimdallAddress) MilestoneStats {
+	return MilestoneStats{Proposer: proposer}
+}
+
+// AddAccepted counts an accepted milestone of the given length in bor blocks
+func (s *MilestoneStats) AddAccepted(length uint64) {
+	s.Accepted++
+	s.TotalLength += length
+	s.AvgLength = s.TotalLength / s.Accepted
+}
+
+// QueryMilestoneStatsParams defines the params for querying the milestone
+// statistics, of all the proposers when the proposer is empty
+type QueryMilestoneStatsParams struct {
+	Proposer hmTypes.HeimdallAddress
+}
+
+// NewQueryMilestoneStatsParams creates a new instance of QueryMilestoneStatsParams
+func NewQueryMilestoneStatsParams(proposer hmTypes.HeimdallAddress) QueryMilestoneStatsParams {
+	return QueryMilestoneStatsParams{Proposer: proposer}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
 )
 
@@ -15,6 +16,8 @@ const (
 	DefaultAvgCheckpointLength  uint64        = 256
 	DefaultMaxCheckpointLength  uint64        = 1024
 	DefaultChildBlockInterval   uint64        = 10000
+	DefaultMilestoneRetention   uint64        = helper.MilestonePruneNumber
+	DefaultMilestoneArchive     bool          = false
 )
 
 // Parameter keys
@@ -23,6 +26,8 @@ var (
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
 	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+	KeyMilestoneRetention   = []byte("MilestoneRetention")
+	KeyMilestoneArchive     = []byte("MilestoneArchive")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -33,6 +38,10 @@ type Params struct {
 	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
 	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+	// number of latest milestones kept in state, 0 keeps the default window
+	MilestoneRetention uint64 `json:"milestone_retention" yaml:"milestone_retention"`
+	// archive mode keeps every milestone, whatever the retention
+	MilestoneArchive bool `json:"milestone_archive" yaml:"milestone_archive"`
 }
 
 // NewParams creates a new Params object
@@ -41,18 +50,24 @@ func NewParams(
 	checkpointLength uint64,
 	maxCheckpointLength uint64,
 	childBlockInterval uint64,
+	milestoneRetention uint64,
+	milestoneArchive bool,
 ) Params {
 	return Params{
 		CheckpointBufferTime: checkpointBufferTime,
 		AvgCheckpointLength:  checkpointLength,
 		MaxCheckpointLength:  maxCheckpointLength,
 		ChildBlockInterval:   childBlockInterval,
+		MilestoneRetention:   milestoneRetention,
+		MilestoneArchive:     milestoneArchive,
 	}
 }
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	milestoneParams := DefaultMilestoneParams()
+
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).RegisterValidatedParamSet(&milestoneParams)
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -64,6 +79,8 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
 		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
 		{KeyChildBlockInterval, &p.ChildBlockInterval},
+		{KeyMilestoneRetention, &p.MilestoneRetention},
+		{KeyMilestoneArchive, &p.MilestoneArchive},
 	}
 }
 
@@ -82,6 +99,8 @@ func DefaultParams() Params {
 		AvgCheckpointLength:  DefaultAvgCheckpointLength,
 		MaxCheckpointLength:  DefaultMaxCheckpointLength,
 		ChildBlockInterval:   DefaultChildBlockInterval,
+		MilestoneRetention:   DefaultMilestoneRetention,
+		MilestoneArchive:     DefaultMilestoneArchive,
 	}
 }
 
@@ -94,6 +113,8 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
 	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("MilestoneRetention: %d\n", p.MilestoneRetention))
+	sb.WriteString(fmt.Sprintf("MilestoneArchive: %t\n", p.MilestoneArchive))
 
 	return sb.String()
 }
@@ -115,6 +136,15 @@ func (p Params) Validate() error {
 	return nil
 }
 
+// GetMilestoneRetention returns the number of latest milestones kept in state
+func (p Params) GetMilestoneRetention() uint64 {
+	if p.MilestoneRetention == 0 {
+		return DefaultMilestoneRetention
+	}
+
+	return p.MilestoneRetention
+}
+
 type Count struct {
 	Count uint64 `json:"count" yaml:"count"`
 }
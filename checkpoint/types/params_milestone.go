@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default milestone parameter values
+const (
+	DefaultMinMilestoneLength       uint64        = helper.MilestoneLength
+	DefaultMilestoneBufferTime      time.Duration = helper.MilestoneBufferTime
+	DefaultMilestoneTimeoutInterval uint64        = helper.MilestoneBufferLength
+)
+
+// Milestone parameter keys
+var (
+	KeyMinMilestoneLength       = []byte("MinMilestoneLength")
+	KeyMilestoneBufferTime      = []byte("MilestoneBufferTime")
+	KeyMilestoneTimeoutInterval = []byte("MilestoneTimeoutInterval")
+)
+
+var _ subspace.ParamSet = &MilestoneParams{}
+
+// MilestoneParams defines the milestone parameters, kept in the checkpoint subspace
+type MilestoneParams struct {
+	// minimum number of bor blocks in a milestone
+	MinMilestoneLength uint64 `json:"min_milestone_length" yaml:"min_milestone_length"`
+	// time after the last milestone, or milestone timeout, before a milestone timeout is accepted
+	MilestoneBufferTime time.Duration `json:"milestone_buffer_time" yaml:"milestone_buffer_time"`
+	// bor blocks without a new milestone before the proposers send a milestone timeout
+	MilestoneTimeoutInterval uint64 `json:"milestone_timeout_interval" yaml:"milestone_timeout_interval"`
+}
+
+// NewMilestoneParams creates a new MilestoneParams object
+func NewMilestoneParams(
+	minMilestoneLength uint64,
+	milestoneBufferTime time.Duration,
+	milestoneTimeoutInterval uint64,
+) MilestoneParams {
+	return MilestoneParams{
+		MinMilestoneLength:       minMilestoneLength,
+		MilestoneBufferTime:      milestoneBufferTime,
+		MilestoneTimeoutInterval: milestoneTimeoutInterval,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of the milestone parameters.
+// nolint
+func (p *MilestoneParams) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyMinMilestoneLength, &p.MinMilestoneLength},
+		{KeyMilestoneBufferTime, &p.MilestoneBufferTime},
+		{KeyMilestoneTimeoutInterval, &p.MilestoneTimeoutInterval},
+	}
+}
+
+// DefaultMilestoneParams returns a default set of milestone parameters.
+func DefaultMilestoneParams() MilestoneParams {
+	return MilestoneParams{
+		MinMilestoneLength:       DefaultMinMilestoneLength,
+		MilestoneBufferTime:      DefaultMilestoneBufferTime,
+		MilestoneTimeoutInterval: DefaultMilestoneTimeoutInterval,
+	}
+}
+
+// String implements the stringer interface.
+func (p MilestoneParams) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("MilestoneParams: \n")
+	sb.WriteString(fmt.Sprintf("MinMilestoneLength: %d\n", p.MinMilestoneLength))
+	sb.WriteString(fmt.Sprintf("MilestoneBufferTime: %s\n", p.MilestoneBufferTime))
+	sb.WriteString(fmt.Sprintf("MilestoneTimeoutInterval: %d\n", p.MilestoneTimeoutInterval))
+
+	return sb.String()
+}
+
+// Validate checks that the milestone parameters have valid values.
+func (p MilestoneParams) Validate() error {
+	if p.MinMilestoneLength == 0 {
+		return fmt.Errorf("MinMilestoneLength should be greater than zero")
+	}
+
+	if p.MilestoneBufferTime <= 0 {
+		return fmt.Errorf("MilestoneBufferTime should be greater than zero")
+	}
+
+	if p.MilestoneTimeoutInterval < p.MinMilestoneLength {
+		return fmt.Errorf("MilestoneTimeoutInterval should not be less than MinMilestoneLength")
+	}
+
+	return nil
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
 	QueryParams           = "params"
@@ -9,6 +13,8 @@ const (
 	QueryLastNoAck        = "last-no-ack"
 	QueryCheckpointList   = "checkpoint-list"
 	QueryNextCheckpoint   = "next-checkpoint"
+	QueryExitProof        = "exit-proof"
+	QueryBorBlockStatus   = "bor-block-status"
 	QueryProposer         = "is-proposer"
 	QueryCurrentProposer  = "current-proposer"
 	StakingQuerierRoute   = "staking"
@@ -33,3 +39,36 @@ type QueryBorChainID struct {
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// QueryExitProofParams defines the params for querying the exit proof of a bor
+// block, or of a log of a bor tx if a tx hash is given
+type QueryExitProofParams struct {
+	BlockNumber uint64
+	TxHash      string
+	LogIndex    uint64
+}
+
+// NewQueryExitProofParams creates a new instance of QueryExitProofParams
+func NewQueryExitProofParams(blockNumber uint64, txHash string, logIndex uint64) QueryExitProofParams {
+	return QueryExitProofParams{BlockNumber: blockNumber, TxHash: txHash, LogIndex: logIndex}
+}
+
+// QueryBorBlockParams defines the params for querying with a bor block number
+type QueryBorBlockParams struct {
+	BlockNumber uint64
+}
+
+// NewQueryBorBlockParams creates a new instance of QueryBorBlockParams
+func NewQueryBorBlockParams(blockNumber uint64) QueryBorBlockParams {
+	return QueryBorBlockParams{BlockNumber: blockNumber}
+}
+
+// BorBlockStatus tells whether a bor block is in an acknowledged checkpoint,
+// and whether a milestone already finalized it
+type BorBlockStatus struct {
+	BlockNumber      uint64              `json:"block_number"`
+	Checkpointed     bool                `json:"checkpointed"`
+	CheckpointNumber uint64              `json:"checkpoint_number,omitempty"`
+	Checkpoint       *hmTypes.Checkpoint `json:"checkpoint,omitempty"`
+	MilestoneCovered bool                `json:"milestone_covered"`
+}
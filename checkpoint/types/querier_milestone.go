@@ -1,11 +1,20 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 const (
 	QueryLatestMilestone      = "milestone-latest"
 	QueryMilestoneByNumber    = "milestone-by-number"
 	QueryCount                = "count"
 	QueryLatestNoAckMilestone = "latest-no-ack-milestone"
 	QueryNoAckMilestoneByID   = "no-ack-milestone-by-id"
+	QueryMilestoneList        = "milestone-list"
+	QueryMilestoneByID        = "milestone-by-id"
+	QueryMilestoneByBorBlock  = "milestone-by-bor-block"
+	QueryMilestoneStats       = "milestone-stats"
+	QueryMilestoneParameters  = "milestone-params"
 )
 
 // QueryMilestoneParams defines the params for querying accounts.
@@ -26,3 +35,14 @@ type QueryMilestoneID struct {
 func NewQueryMilestoneID(id string) QueryMilestoneID {
 	return QueryMilestoneID{MilestoneID: id}
 }
+
+// MilestoneRecord is a milestone of the history along with its milestone number
+type MilestoneRecord struct {
+	Number    uint64            `json:"number"`
+	Milestone hmTypes.Milestone `json:"milestone"`
+}
+
+// NewMilestoneRecord creates a new instance of MilestoneRecord
+func NewMilestoneRecord(number uint64, milestone hmTypes.Milestone) MilestoneRecord {
+	return MilestoneRecord{Number: number, Milestone: milestone}
+}
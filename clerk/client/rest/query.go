@@ -233,7 +233,7 @@ func recordListHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			logger.Info("Serving event record list", "from-id", fromID, "to-time", toTime)
 
 			// get result by till time-range query
-			res, err = tillTimeRangeQuery(cliCtx, fromID, toTime, limit)
+			res, err = TillTimeRangeQuery(cliCtx, fromID, toTime, limit)
 		} else {
 			// get result by range query
 			res, err = rangeQuery(cliCtx, page, limit)
@@ -384,7 +384,9 @@ func rangeQuery(cliCtx context.CLIContext, page uint64, limit uint64) ([]byte, e
 	return res, nil
 }
 
-func tillTimeRangeQuery(cliCtx context.CLIContext, fromID uint64, toTime int64, limit uint64) ([]byte, error) {
+// TillTimeRangeQuery returns at most limit records starting from fromID whose
+// record time is before toTime, as a JSON array
+func TillTimeRangeQuery(cliCtx context.CLIContext, fromID uint64, toTime int64, limit uint64) ([]byte, error) {
 	result := make([]*types.EventRecord, 0, limit)
 
 	// if from id not found, return empty result
@@ -101,7 +101,7 @@ func handleQueryRecordSequence(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	chainParams := keeper.chainKeeper.GetParams(ctx)
 
 	// get main tx receipt
-	receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(), chainParams.MainchainTxConfirmations)
+	receipt, err := chainParams.GetMainchainTxReceipt(contractCallerObj, hmTypes.HexToHeimdallHash(params.TxHash).EthHash())
 	if err != nil || receipt == nil {
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
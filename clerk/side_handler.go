@@ -56,9 +56,9 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 	chainParams := params.ChainParams
 
 	// get confirmed tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if receipt == nil || err != nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeWaitFrConfirmation, msg.TxHash, err)
 	}
 
 	// get event log for topup
@@ -148,6 +148,33 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgEventRecord() {
 		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
 	})
 
+	t.Run("ReceiptQuorumMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		logIndex := uint64(300)
+		blockNumber := uint64(52)
+		txHash := hmTypes.HexToHeimdallHash("receipt mismatch hash")
+
+		msg := types.NewMsgEventRecord(
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			txHash,
+			logIndex,
+			blockNumber,
+			id,
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			make([]byte, 0),
+			suite.chainID,
+		)
+
+		// mock external calls -- endpoints disagree on the receipt
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		// execute handler
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code, "Side tx handler should fail with receipt mismatch")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+
 	t.Run("NoLog", func(t *testing.T) {
 		suite.contractCaller = mocks.IContractCaller{}
 
@@ -145,6 +145,14 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:   "tx",
 		Short: "Transactions subcommands",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := rootCmd.PersistentPreRunE(cmd, args); err != nil {
+				return err
+			}
+
+			// the txs are signed with the validator key
+			return helper.LoadValidatorKey()
+		},
 	}
 
 	txCmd.AddCommand(
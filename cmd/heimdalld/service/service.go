@@ -437,10 +437,20 @@ func startInProcess(cmd *cobra.Command, shutdownCtx context.Context, ctx *server
 
 	server.UpgradeOldPrivValFile(cfg)
 
+	privValidator, err := loadPrivValidator(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to load priv validator: %s", err)
+	}
+
+	// the bridge and the rest server sign with the validator key
+	if err := helper.LoadValidatorKey(); err != nil {
+		return fmt.Errorf("failed to load validator key: %s", err)
+	}
+
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
 		cfg,
-		privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		node.DefaultGenesisDocProviderFunc(cfg),
@@ -589,10 +599,15 @@ func showPrivateKeyCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "show-privatekey",
 		Short: "Print the account's private key",
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			// init heimdall config
 			helper.InitHeimdallConfig("")
 
+			// the remote signer never hands out the key
+			if helper.GetConfig().RemoteSignerURL != "" {
+				return fmt.Errorf("the private key is held by the remote signer at %s", helper.GetConfig().RemoteSignerURL)
+			}
+
 			// get private and public keys
 			privObject := helper.GetPrivKey()
 
@@ -607,6 +622,8 @@ func showPrivateKeyCmd() *cobra.Command {
 
 			// prints json info
 			fmt.Printf("%s", string(b))
+
+			return nil
 		},
 	}
 }
@@ -763,6 +780,32 @@ func InitializeNodeValidatorFiles(
 	return nodeID, valPubKey, FilePv.Key.PrivKey, nil
 }
 
+// loadPrivValidator loads the priv validator of the node, which signs with the
+// keystore key if a keystore is configured. With a remote signer no local key
+// is loaded, tendermint signs the votes through the signer client listening
+// on priv_validator_laddr instead of the returned priv validator.
+func loadPrivValidator(config *cfg.Config) (tmTypes.PrivValidator, error) {
+	helper.InitHeimdallConfig("")
+
+	if helper.GetConfig().RemoteSignerURL != "" {
+		if config.PrivValidatorListenAddr == "" {
+			return nil, errors.New("remote signer is configured, set priv_validator_laddr to sign the votes remotely too")
+		}
+
+		return tmTypes.NewMockPV(), nil
+	}
+
+	if err := helper.LoadValidatorKey(); err != nil {
+		return nil, err
+	}
+
+	if helper.GetConfig().KeystoreFile != "" {
+		return helper.NewKeystoreFilePV(helper.GetPrivKey(), config.PrivValidatorStateFile())
+	}
+
+	return privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()), nil
+}
+
 // WriteDefaultHeimdallConfig writes default heimdall config to the given path
 func WriteDefaultHeimdallConfig(path string, conf helper.Configuration) {
 	// Don't write if config file in path already exists
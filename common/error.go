@@ -1,12 +1,15 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -68,12 +71,14 @@ const (
 
 	CodeInvalidReceipt         CodeType = 5501
 	CodeSideTxValidationFailed CodeType = 5502
+	CodeReceiptQuorumMismatch  CodeType = 5503
 
 	CodeValSigningInfoSave     CodeType = 6501
 	CodeErrValUnjail           CodeType = 6502
 	CodeSlashInfoDetails       CodeType = 6503
 	CodeTickNotInContinuity    CodeType = 6504
 	CodeTickAckNotInContinuity CodeType = 6505
+	CodeNoSigningInfo          CodeType = 6506
 
 	CodeNoMilestone              CodeType = 7501
 	CodeMilestoneNotInContinuity CodeType = 7502
@@ -299,6 +304,18 @@ func ErrorSideTx(codespace sdk.CodespaceType, code CodeType) (res abci.ResponseD
 	return
 }
 
+// ErrorSideTxReceipt represents the side-tx error of a main chain receipt
+// which couldn't be fetched. A receipt the main chain endpoints disagree on
+// is reported with CodeReceiptQuorumMismatch instead of the given code.
+func ErrorSideTxReceipt(logger log.Logger, codespace sdk.CodespaceType, code CodeType, txHash types.HeimdallHash, err error) abci.ResponseDeliverSideTx {
+	if errors.Is(err, helper.ErrQuorumMismatch) {
+		logger.Error("Main chain endpoints returned different receipts", "txHash", txHash, "error", err)
+		return ErrorSideTx(codespace, CodeReceiptQuorumMismatch)
+	}
+
+	return ErrorSideTx(codespace, code)
+}
+
 func ErrSideTxValidation(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeSideTxValidationFailed, "External call majority validation failed. ")
 }
@@ -359,6 +376,8 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "Unable to connect to chain"
 	case CodeWaitFrConfirmation:
 		return "wait for confirmation time before sending transaction"
+	case CodeReceiptQuorumMismatch:
+		return "main chain endpoints returned different receipts"
 	case CodeValPubkeyMismatch:
 		return "Signer Pubkey mismatch between event and msg"
 	case CodeSpanNotCountinuous:
@@ -411,3 +430,7 @@ func ErrTickNotInContinuity(codespace sdk.CodespaceType) sdk.Error {
 func ErrTickAckNotInContinuity(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeTickAckNotInContinuity, "Tick-ack not in continuity")
 }
+
+func ErrNoSigningInfo(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeNoSigningInfo, "Validator signing info not found")
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -40,6 +41,9 @@ const (
 	unJailedEvent       = "UnJailed"
 )
 
+// maticBatchSize is the max number of requests in a child chain batch call
+const maticBatchSize = 100
+
 // ContractsABIsMap is a cached map holding the ABIs of the contracts
 var ContractsABIsMap = make(map[string]*abi.ABI)
 
@@ -52,13 +56,16 @@ type IContractCaller interface {
 	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
 	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
-	SendCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (err error)
-	SendTick(signedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (err error)
+	SendCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (*ethTypes.Transaction, error)
+	SendTick(signedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (*ethTypes.Transaction, error)
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
+	GetMaticChainBlockHeaders(start uint64, end uint64) ([]*ethTypes.Header, error)
+	GetMaticBlockReceipts(blockNumber uint64) ([]*ethTypes.Receipt, error)
 	IsTxConfirmed(common.Hash, uint64) bool
 	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
+	GetFinalizedTxReceipt(common.Hash, rpc.BlockNumber) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
 
 	// decode header event
@@ -107,6 +114,11 @@ type ContractCaller struct {
 	MaticChainRPC     *rpc.Client
 	MaticChainTimeout time.Duration
 
+	// main chain endpoints and the number of them which must agree on
+	// receipts and reach the finalized block, quorum reads are off when unset
+	MainChainEndpoints *RPCEndpoints
+	MainChainQuorum    int
+
 	RootChainABI     abi.ABI
 	StakingInfoABI   abi.ABI
 	ValidatorSetABI  abi.ABI
@@ -140,6 +152,8 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.MaticChainTimeout = config.BorRPCTimeout
 	contractCallerObj.MainChainRPC = GetMainChainRPCClient()
 	contractCallerObj.MaticChainRPC = GetMaticRPCClient()
+	contractCallerObj.MainChainEndpoints = GetMainChainEndpoints()
+	contractCallerObj.MainChainQuorum = config.EthRPCQuorum
 	contractCallerObj.ReceiptCache, err = lru.New(1000)
 
 	if err != nil {
@@ -405,16 +419,45 @@ func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int) (header *ethTypes.
 
 // GetMainChainFinalizedBlock returns finalized main chain block header (post-merge)
 func (c *ContractCaller) GetMainChainFinalizedBlock() (header *ethTypes.Header, err error) {
+	return c.getMainChainTaggedBlock(rpc.FinalizedBlockNumber)
+}
+
+// GetMainChainSafeBlock returns safe main chain block header (post-merge)
+func (c *ContractCaller) GetMainChainSafeBlock() (header *ethTypes.Header, err error) {
+	return c.getMainChainTaggedBlock(rpc.SafeBlockNumber)
+}
+
+// getMainChainTaggedBlock returns the main chain block header of a tag, such as finalized or safe
+func (c *ContractCaller) getMainChainTaggedBlock(tag rpc.BlockNumber) (header *ethTypes.Header, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
 	defer cancel()
 
-	latestFinalizedBlock, err := c.MainChainClient.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if c.mainChainQuorumEnabled() {
+		// endpoints may lag behind each other, so the tagged block is the
+		// lowest one of the quorum rather than one they all agree on
+		taggedBlock, err := quorumMinRead(ctx, c.MainChainEndpoints.Clients(), c.MainChainQuorum, func(ctx context.Context, client *ethclient.Client) (*ethTypes.Header, uint64, error) {
+			header, err := client.HeaderByNumber(ctx, big.NewInt(int64(tag)))
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return header, header.Number.Uint64(), nil
+		})
+		if err != nil {
+			Logger.Error("Unable to get tagged block from main chain quorum", "tag", tag, "error", err)
+			return nil, err
+		}
+
+		return taggedBlock, nil
+	}
+
+	taggedBlock, err := c.MainChainClient.HeaderByNumber(ctx, big.NewInt(int64(tag)))
 	if err != nil {
 		Logger.Error("Unable to connect to main chain", "error", err)
 		return
 	}
 
-	return latestFinalizedBlock, nil
+	return taggedBlock, nil
 }
 
 // GetMainChainBlockTime returns main chain block time
@@ -479,22 +522,9 @@ func (c *ContractCaller) IsTxConfirmed(tx common.Hash, requiredConfirmations uin
 
 // GetConfirmedTxReceipt returns confirmed tx receipt
 func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
-	var receipt *ethTypes.Receipt
-
-	receiptCache, ok := c.ReceiptCache.Get(tx.String())
-	if !ok {
-		var err error
-
-		// get main tx receipt
-		receipt, err = c.GetMainTxReceipt(tx)
-		if err != nil {
-			Logger.Error("Error while fetching mainChain receipt", "txHash", tx.Hex(), "error", err)
-			return nil, err
-		}
-
-		c.ReceiptCache.Add(tx.String(), receipt)
-	} else {
-		receipt, _ = receiptCache.(*ethTypes.Receipt)
+	receipt, err := c.getCachedMainTxReceipt(tx)
+	if err != nil {
+		return nil, err
 	}
 
 	receiptBlockNumber := receipt.BlockNumber.Uint64()
@@ -534,6 +564,59 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmat
 	return receipt, nil
 }
 
+// GetFinalizedTxReceipt returns the receipt of a main chain tx included in
+// the block of the tag, finalized or safe. Unlike GetConfirmedTxReceipt, it
+// doesn't fall back on confirmations if the tag isn't available.
+func (c *ContractCaller) GetFinalizedTxReceipt(tx common.Hash, tag rpc.BlockNumber) (*ethTypes.Receipt, error) {
+	receipt, err := c.getCachedMainTxReceipt(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	taggedBlock, err := c.getMainChainTaggedBlock(tag)
+	if err != nil {
+		Logger.Error("error getting tagged block from main chain", "tag", tag, "error", err)
+		return nil, err
+	}
+
+	Logger.Debug("Tagged block on main chain obtained", "tag", tag, "Block", taggedBlock.Number.Uint64(), "receipt block", receipt.BlockNumber.Uint64())
+
+	if receipt.BlockNumber.Uint64() > taggedBlock.Number.Uint64() {
+		return nil, errors.New("tx not final yet")
+	}
+
+	return receipt, nil
+}
+
+// getCachedMainTxReceipt returns the main chain tx receipt, from the cache if available
+func (c *ContractCaller) getCachedMainTxReceipt(tx common.Hash) (*ethTypes.Receipt, error) {
+	if receiptCache, ok := c.ReceiptCache.Get(tx.String()); ok {
+		receipt, _ := receiptCache.(*ethTypes.Receipt)
+		return receipt, nil
+	}
+
+	var (
+		receipt *ethTypes.Receipt
+		err     error
+	)
+
+	// get main tx receipt
+	if c.mainChainQuorumEnabled() {
+		receipt, err = c.GetMainTxReceiptWithQuorum(tx)
+	} else {
+		receipt, err = c.GetMainTxReceipt(tx)
+	}
+
+	if err != nil {
+		Logger.Error("Error while fetching mainChain receipt", "txHash", tx.Hex(), "error", err)
+		return nil, err
+	}
+
+	c.ReceiptCache.Add(tx.String(), receipt)
+
+	return receipt, nil
+}
+
 //
 // Validator decode events
 //
@@ -852,6 +935,108 @@ func (c *ContractCaller) GetBlockByNumber(ctx context.Context, blockNumber uint6
 	return block
 }
 
+// GetMaticChainBlockHeaders returns the child chain block headers from start to end, both included
+func (c *ContractCaller) GetMaticChainBlockHeaders(start uint64, end uint64) ([]*ethTypes.Header, error) {
+	if start > end {
+		return nil, fmt.Errorf("invalid block range %v - %v", start, end)
+	}
+
+	headers := make([]*ethTypes.Header, 0, end-start+1)
+
+	for from := start; from <= end; from += maticBatchSize {
+		to := from + maticBatchSize - 1
+		if to > end {
+			to = end
+		}
+
+		batch := make([]rpc.BatchElem, 0, to-from+1)
+		result := make([]*ethTypes.Header, to-from+1)
+
+		for i := range result {
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.EncodeUint64(from + uint64(i)), false},
+				Result: &result[i],
+			})
+		}
+
+		if err := c.batchCallMatic(batch); err != nil {
+			return nil, err
+		}
+
+		for i, header := range result {
+			if header == nil {
+				return nil, fmt.Errorf("block %v not found on child chain", from+uint64(i))
+			}
+		}
+
+		headers = append(headers, result...)
+	}
+
+	return headers, nil
+}
+
+// GetMaticBlockReceipts returns the receipts of all the txs of a child chain block, in block order
+func (c *ContractCaller) GetMaticBlockReceipts(blockNumber uint64) ([]*ethTypes.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
+	defer cancel()
+
+	block, err := c.MaticChainClient.BlockByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	txs := block.Transactions()
+	receipts := make([]*ethTypes.Receipt, len(txs))
+
+	for from := 0; from < len(txs); from += maticBatchSize {
+		to := from + maticBatchSize
+		if to > len(txs) {
+			to = len(txs)
+		}
+
+		batch := make([]rpc.BatchElem, 0, to-from)
+
+		for i := from; i < to; i++ {
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []interface{}{txs[i].Hash()},
+				Result: &receipts[i],
+			})
+		}
+
+		if err := c.batchCallMatic(batch); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, receipt := range receipts {
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt of tx %v not found on child chain", txs[i].Hash())
+		}
+	}
+
+	return receipts, nil
+}
+
+// batchCallMatic sends the batch of requests to the child chain
+func (c *ContractCaller) batchCallMatic(batch []rpc.BatchElem) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
+	defer cancel()
+
+	if err := c.MaticChainRPC.BatchCallContext(ctx, batch); err != nil {
+		return err
+	}
+
+	for _, elem := range batch {
+		if elem.Error != nil {
+			return elem.Error
+		}
+	}
+
+	return nil
+}
+
 //
 // Receipt functions
 //
@@ -864,6 +1049,36 @@ func (c *ContractCaller) GetMainTxReceipt(txHash common.Hash) (*ethTypes.Receipt
 	return c.getTxReceipt(ctx, c.MainChainClient, txHash)
 }
 
+// GetMainTxReceiptWithQuorum returns main tx receipt once enough main chain
+// endpoints returned the same receipt
+func (c *ContractCaller) GetMainTxReceiptWithQuorum(txHash common.Hash) (*ethTypes.Receipt, error) {
+	if c.MainChainEndpoints == nil {
+		return nil, fmt.Errorf("%w: no main chain fallback endpoints configured", ErrQuorumNotReached)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+	defer cancel()
+
+	quorum := c.MainChainQuorum
+	if quorum < 1 {
+		quorum = 1
+	}
+
+	return quorumRead(ctx, c.MainChainEndpoints.Clients(), quorum, func(ctx context.Context, client *ethclient.Client) (*ethTypes.Receipt, string, error) {
+		receipt, err := c.getTxReceipt(ctx, client, txHash)
+		if err != nil {
+			return nil, "", err
+		}
+
+		key, err := receiptKey(receipt)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return receipt, key, nil
+	})
+}
+
 // GetMaticTxReceipt returns matic tx receipt
 func (c *ContractCaller) GetMaticTxReceipt(txHash common.Hash) (*ethTypes.Receipt, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
@@ -899,6 +1114,28 @@ func (c *ContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte,
 
 // utility and helper methods
 
+// mainChainQuorumEnabled returns true if main chain reads need a quorum
+func (c *ContractCaller) mainChainQuorumEnabled() bool {
+	return c.MainChainEndpoints != nil && c.MainChainQuorum > 1
+}
+
+// receiptKey identifies the receipt content, including its block number and
+// hash and the log indexes the events are decoded with, so receipts from
+// different endpoints can be compared
+func receiptKey(receipt *ethTypes.Receipt) (string, error) {
+	consensusReceipt, err := receipt.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	logIndexes := make([]uint, 0, len(receipt.Logs))
+	for _, vLog := range receipt.Logs {
+		logIndexes = append(logIndexes, vLog.Index)
+	}
+
+	return fmt.Sprintf("%s-%x-%d-%v-%x", receipt.BlockNumber, receipt.BlockHash, receipt.TransactionIndex, logIndexes, consensusReceipt), nil
+}
+
 // populateABIs fills the package level cache for contracts' ABIs
 // When called the first time, ContractsABIsMap will be filled and getABI method won't be invoked the next times
 // This reduces the number of calls to json decode methods made by the contract caller
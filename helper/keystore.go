@@ -0,0 +1,102 @@
+package helper
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/console/prompt"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/privval"
+
+	"github.com/maticnetwork/heimdall/file"
+)
+
+// LoadKeystore decrypts the validator key of the keystore file. The passphrase
+// is read from the password file, or prompted for if no password file is set.
+func LoadKeystore(keystoreFile string, passwordFile string) (secp256k1.PrivKeySecp256k1, error) {
+	var privKey secp256k1.PrivKeySecp256k1
+
+	if err := file.PermCheck(keystoreFile, secretFilePerm); err != nil {
+		Logger.Error(err.Error())
+	}
+
+	keyJSON, err := os.ReadFile(keystoreFile)
+	if err != nil {
+		return privKey, fmt.Errorf("unable to read keystore: %w", err)
+	}
+
+	passphrase, err := readKeystorePassphrase(passwordFile)
+	if err != nil {
+		return privKey, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return privKey, fmt.Errorf("unable to decrypt keystore %v: %w", keystoreFile, err)
+	}
+
+	copy(privKey[:], ethCrypto.FromECDSA(key.PrivateKey))
+
+	return privKey, nil
+}
+
+// readKeystorePassphrase reads the keystore passphrase from the first line of
+// the password file, or prompts for it
+func readKeystorePassphrase(passwordFile string) (string, error) {
+	if passwordFile == "" {
+		passphrase, err := prompt.Stdin.PromptPassword("Keystore passphrase: ")
+		if err != nil {
+			return "", fmt.Errorf("unable to read keystore passphrase: %w", err)
+		}
+
+		return passphrase, nil
+	}
+
+	if err := file.PermCheck(passwordFile, secretFilePerm); err != nil {
+		Logger.Error(err.Error())
+	}
+
+	data, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read keystore password file: %w", err)
+	}
+
+	return strings.TrimRight(strings.SplitN(string(data), "\n", 2)[0], "\r"), nil
+}
+
+// NewKeystoreFilePV returns the tendermint priv validator signing with the
+// validator key loaded from the keystore. The key is never written to disk,
+// only the last sign state is persisted to the state file.
+func NewKeystoreFilePV(privKey secp256k1.PrivKeySecp256k1, stateFile string) (*privval.FilePV, error) {
+	pv := privval.GenFilePV("", stateFile)
+	pv.Key.PrivKey = privKey
+	pv.Key.PubKey = privKey.PubKey()
+	pv.Key.Address = pv.Key.PubKey.Address()
+
+	if !cmn.FileExists(stateFile) {
+		pv.LastSignState.Save()
+		return pv, nil
+	}
+
+	stateJSON, err := os.ReadFile(stateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var state privval.FilePVLastSignState
+	if err := cdc.UnmarshalJSON(stateJSON, &state); err != nil {
+		return nil, fmt.Errorf("error reading priv validator state from %v: %w", stateFile, err)
+	}
+
+	pv.LastSignState.Height = state.Height
+	pv.LastSignState.Round = state.Round
+	pv.LastSignState.Step = state.Step
+	pv.LastSignState.Signature = state.Signature
+	pv.LastSignState.SignBytes = state.SignBytes
+
+	return pv, nil
+}
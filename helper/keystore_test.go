@@ -0,0 +1,109 @@
+package helper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func writeTestKeystore(t *testing.T, dir string, privKey secp256k1.PrivKeySecp256k1, passphrase string) string {
+	t.Helper()
+
+	pk, err := ethCrypto.ToECDSA(privKey[:])
+	require.NoError(t, err)
+
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    ethCrypto.PubkeyToAddress(pk.PublicKey),
+		PrivateKey: pk,
+	}, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	keystoreFile := filepath.Join(dir, "keystore.json")
+	require.NoError(t, os.WriteFile(keystoreFile, keyJSON, secretFilePerm))
+
+	return keystoreFile
+}
+
+func TestLoadKeystore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	privKey := secp256k1.GenPrivKey()
+	keystoreFile := writeTestKeystore(t, dir, privKey, "secret")
+
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("secret\n"), secretFilePerm))
+
+	loaded, err := LoadKeystore(keystoreFile, passwordFile)
+	require.NoError(t, err)
+	require.Equal(t, privKey, loaded)
+
+	require.NoError(t, os.WriteFile(passwordFile, []byte("wrong"), secretFilePerm))
+
+	_, err = LoadKeystore(keystoreFile, passwordFile)
+	require.Error(t, err)
+}
+
+func TestNewKeystoreFilePV(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.GenPrivKey()
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+
+	// the state file is created on first use
+	pv, err := NewKeystoreFilePV(privKey, stateFile)
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey(), pv.GetPubKey())
+	require.FileExists(t, stateFile)
+
+	pv.LastSignState.Height = 10
+	pv.LastSignState.Round = 1
+	pv.LastSignState.Step = 2
+	pv.LastSignState.Save()
+
+	// and the last sign state is kept across restarts
+	pv, err = NewKeystoreFilePV(privKey, stateFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), pv.LastSignState.Height)
+	require.Equal(t, 1, pv.LastSignState.Round)
+	require.Equal(t, int8(2), pv.LastSignState.Step)
+}
+
+func TestLoadValidatorKeyKeystore(t *testing.T) {
+	prevConf, prevSigner, prevPriv, prevPub := conf, signer, privObject, pubObject
+
+	t.Cleanup(func() {
+		conf, signer, privObject, pubObject = prevConf, prevSigner, prevPriv, prevPub
+		validatorKeyLoaded = false
+	})
+
+	dir := t.TempDir()
+	privKey := secp256k1.GenPrivKey()
+
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("wrong"), secretFilePerm))
+
+	conf.RemoteSignerURL = ""
+	conf.KeystoreFile = writeTestKeystore(t, dir, privKey, "secret")
+	conf.KeystorePasswordFile = passwordFile
+	validatorKeyLoaded = false
+
+	// a wrong passphrase is reported to the caller
+	require.Error(t, LoadValidatorKey())
+
+	require.NoError(t, os.WriteFile(passwordFile, []byte("secret"), secretFilePerm))
+	require.NoError(t, LoadValidatorKey())
+	require.Equal(t, privKey, GetPrivKey())
+	require.Equal(t, privKey.PubKey(), GetPubKey())
+	require.Equal(t, privKey.PubKey(), GetSigner().PubKey())
+}
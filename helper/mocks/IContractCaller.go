@@ -12,6 +12,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	rpc "github.com/ethereum/go-ethereum/rpc"
+
 	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
 
 	slashmanager "github.com/maticnetwork/heimdall/contracts/slashmanager"
@@ -455,6 +457,29 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64) (*
 	return r0, r1
 }
 
+// GetFinalizedTxReceipt provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) GetFinalizedTxReceipt(_a0 common.Hash, _a1 rpc.BlockNumber) (*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *types.Receipt
+	if rf, ok := ret.Get(0).(func(common.Hash, rpc.BlockNumber) *types.Receipt); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Receipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Hash, rpc.BlockNumber) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeaderInfo provides a mock function with given fields: headerID, rootChainInstance, childBlockInterval
 func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
 	ret := _m.Called(headerID, rootChainInstance, childBlockInterval)
@@ -644,6 +669,52 @@ func (_m *IContractCaller) GetMaticTxReceipt(_a0 common.Hash) (*types.Receipt, e
 	return r0, r1
 }
 
+// GetMaticBlockReceipts provides a mock function with given fields: blockNumber
+func (_m *IContractCaller) GetMaticBlockReceipts(blockNumber uint64) ([]*types.Receipt, error) {
+	ret := _m.Called(blockNumber)
+
+	var r0 []*types.Receipt
+	if rf, ok := ret.Get(0).(func(uint64) []*types.Receipt); ok {
+		r0 = rf(blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Receipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMaticChainBlockHeaders provides a mock function with given fields: start, end
+func (_m *IContractCaller) GetMaticChainBlockHeaders(start uint64, end uint64) ([]*types.Header, error) {
+	ret := _m.Called(start, end)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(uint64, uint64) []*types.Header); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRootChainInstance provides a mock function with given fields: rootchainAddress
 func (_m *IContractCaller) GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error) {
 	ret := _m.Called(rootchainAddress)
@@ -928,31 +999,49 @@ func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash, _a1 uint64) bool {
 }
 
 // SendCheckpoint provides a mock function with given fields: sigedData, sigs, rootchainAddress, rootChainInstance
-func (_m *IContractCaller) SendCheckpoint(sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain) error {
+func (_m *IContractCaller) SendCheckpoint(sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain) (*types.Transaction, error) {
 	ret := _m.Called(sigedData, sigs, rootchainAddress, rootChainInstance)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, common.Address, *rootchain.Rootchain) error); ok {
+	var r0 *types.Transaction
+	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, common.Address, *rootchain.Rootchain) *types.Transaction); ok {
 		r0 = rf(sigedData, sigs, rootchainAddress, rootChainInstance)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, [][3]*big.Int, common.Address, *rootchain.Rootchain) error); ok {
+		r1 = rf(sigedData, sigs, rootchainAddress, rootChainInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // SendTick provides a mock function with given fields: sigedData, sigs, slashManagerAddress, slashManagerInstance
-func (_m *IContractCaller) SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) error {
+func (_m *IContractCaller) SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (*types.Transaction, error) {
 	ret := _m.Called(sigedData, sigs, slashManagerAddress, slashManagerInstance)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, []byte, common.Address, *slashmanager.Slashmanager) error); ok {
+	var r0 *types.Transaction
+	if rf, ok := ret.Get(0).(func([]byte, []byte, common.Address, *slashmanager.Slashmanager) *types.Transaction); ok {
 		r0 = rf(sigedData, sigs, slashManagerAddress, slashManagerInstance)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, []byte, common.Address, *slashmanager.Slashmanager) error); ok {
+		r1 = rf(sigedData, sigs, slashManagerAddress, slashManagerInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
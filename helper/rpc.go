@@ -0,0 +1,376 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// an endpoint this many blocks behind the best known head is not used
+const rpcMaxBlockLag = 64
+
+var (
+	// ErrQuorumNotReached is returned when not enough endpoints answered a quorum read
+	ErrQuorumNotReached = errors.New("rpc quorum not reached")
+	// ErrQuorumMismatch is returned when the endpoints answered a quorum read differently
+	ErrQuorumMismatch = errors.New("rpc endpoints disagree")
+)
+
+// rpcEndpoint is a json-rpc endpoint of a chain
+type rpcEndpoint struct {
+	url     *url.URL
+	client  *ethclient.Client // direct client, used for health checks and quorum reads
+	healthy int32
+}
+
+func (e *rpcEndpoint) isHealthy() bool {
+	return atomic.LoadInt32(&e.healthy) == 1
+}
+
+func (e *rpcEndpoint) setHealthy(healthy bool) bool {
+	var value int32
+	if healthy {
+		value = 1
+	}
+
+	return atomic.SwapInt32(&e.healthy, value) != value
+}
+
+// RPCEndpoints spreads the json-rpc calls of a chain over several http
+// endpoints. Calls go to the first healthy endpoint in the configured order
+// and fail over to the next ones on connection errors, rate limits and
+// server errors. It is used as the http transport of the chain rpc client,
+// so every client call fails over transparently.
+type RPCEndpoints struct {
+	chain     string
+	endpoints []*rpcEndpoint
+	transport http.RoundTripper
+}
+
+// NewRPCEndpoints returns the endpoints of the chain, the first url being the
+// preferred one
+func NewRPCEndpoints(chain string, urls []string) (*RPCEndpoints, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no rpc url for chain %v", chain)
+	}
+
+	endpoints := &RPCEndpoints{
+		chain:     chain,
+		transport: http.DefaultTransport,
+	}
+
+	for _, rawURL := range urls {
+		endpointURL, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+			return nil, fmt.Errorf("rpc failover needs http endpoints, got %v for chain %v", rawURL, chain)
+		}
+
+		rpcClient, err := rpc.DialHTTP(rawURL)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints.endpoints = append(endpoints.endpoints, &rpcEndpoint{
+			url:     endpointURL,
+			client:  ethclient.NewClient(rpcClient),
+			healthy: 1,
+		})
+	}
+
+	return endpoints, nil
+}
+
+// Dial returns an rpc client failing over between the endpoints
+func (e *RPCEndpoints) Dial() (*rpc.Client, error) {
+	return rpc.DialHTTPWithClient(e.endpoints[0].url.String(), &http.Client{Transport: e})
+}
+
+// Clients returns a direct client for every healthy endpoint, or for all
+// endpoints if none is healthy
+func (e *RPCEndpoints) Clients() []*ethclient.Client {
+	clients := make([]*ethclient.Client, 0, len(e.endpoints))
+
+	for _, endpoint := range e.ordered() {
+		clients = append(clients, endpoint.client)
+	}
+
+	return clients
+}
+
+// ordered returns the healthy endpoints in the configured order, followed
+// by the unhealthy ones as a last resort
+func (e *RPCEndpoints) ordered() []*rpcEndpoint {
+	ordered := make([]*rpcEndpoint, 0, len(e.endpoints))
+
+	for _, endpoint := range e.endpoints {
+		if endpoint.isHealthy() {
+			ordered = append(ordered, endpoint)
+		}
+	}
+
+	for _, endpoint := range e.endpoints {
+		if !endpoint.isHealthy() {
+			ordered = append(ordered, endpoint)
+		}
+	}
+
+	return ordered
+}
+
+// RoundTrip sends the json-rpc request to the endpoints until one answers
+func (e *RPCEndpoints) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+
+		req.Body.Close()
+	}
+
+	var lastErr error
+
+	for _, endpoint := range e.ordered() {
+		endpointReq := req.Clone(req.Context())
+		endpointReq.URL = endpoint.url
+		endpointReq.Host = ""
+		endpointReq.Body = io.NopCloser(bytes.NewReader(body))
+		endpointReq.ContentLength = int64(len(body))
+
+		resp, err := e.transport.RoundTrip(endpointReq)
+		if err == nil && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+			return resp, nil
+		}
+
+		if err == nil {
+			resp.Body.Close()
+			err = fmt.Errorf("%v: %v", endpoint.url.Host, resp.Status)
+		}
+
+		// the caller gave up, the endpoint is not to blame
+		if req.Context().Err() != nil {
+			return nil, err
+		}
+
+		if endpoint.setHealthy(false) {
+			Logger.Error("RPC endpoint failed, failing over", "chain", e.chain, "endpoint", endpoint.url.Host, "error", err)
+		}
+
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// CheckHealth marks the endpoints answering with a recent head as healthy
+func (e *RPCEndpoints) CheckHealth(ctx context.Context, timeout time.Duration) {
+	heads := make([]uint64, len(e.endpoints))
+	errs := make([]error, len(e.endpoints))
+
+	var wg sync.WaitGroup
+
+	for i, endpoint := range e.endpoints {
+		wg.Add(1)
+
+		go func(i int, endpoint *rpcEndpoint) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			heads[i], errs[i] = endpoint.client.BlockNumber(ctx)
+		}(i, endpoint)
+	}
+
+	wg.Wait()
+
+	var bestHead uint64
+
+	for i := range e.endpoints {
+		if errs[i] == nil && heads[i] > bestHead {
+			bestHead = heads[i]
+		}
+	}
+
+	for i, endpoint := range e.endpoints {
+		healthy := errs[i] == nil && heads[i]+rpcMaxBlockLag >= bestHead
+
+		if endpoint.setHealthy(healthy) {
+			Logger.Info("RPC endpoint health changed", "chain", e.chain, "endpoint", endpoint.url.Host, "healthy", healthy, "head", heads[i], "bestHead", bestHead, "error", errs[i])
+		}
+	}
+}
+
+// StartHealthCheck checks the endpoints health on every interval until the
+// context is done
+func (e *RPCEndpoints) StartHealthCheck(ctx context.Context, interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.CheckHealth(ctx, timeout)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// dialChain dials the chain rpc. With fallback urls, the client fails over
+// between the endpoints, which are health checked in the background.
+func dialChain(chain string, rpcURL string, fallbackURLs []string, timeout time.Duration) (*rpc.Client, *RPCEndpoints, error) {
+	if len(fallbackURLs) == 0 {
+		rpcClient, err := rpc.Dial(rpcURL)
+		return rpcClient, nil, err
+	}
+
+	endpoints, err := NewRPCEndpoints(chain, append([]string{rpcURL}, fallbackURLs...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rpcClient, err := endpoints.Dial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	go endpoints.StartHealthCheck(context.Background(), conf.RPCHealthCheckInterval, timeout)
+
+	return rpcClient, endpoints, nil
+}
+
+// quorumAnswer is the answer of an endpoint to a quorum read
+type quorumAnswer[T any] struct {
+	value T
+	key   string
+	err   error
+}
+
+// readEndpoints runs the read on every endpoint concurrently and returns
+// their answers
+func readEndpoints[T any](ctx context.Context, clients []*ethclient.Client, read func(context.Context, *ethclient.Client) (T, string, error)) []quorumAnswer[T] {
+	answers := make([]quorumAnswer[T], len(clients))
+
+	var wg sync.WaitGroup
+
+	for i, client := range clients {
+		wg.Add(1)
+
+		go func(i int, client *ethclient.Client) {
+			defer wg.Done()
+
+			answers[i].value, answers[i].key, answers[i].err = read(ctx, client)
+		}(i, client)
+	}
+
+	wg.Wait()
+
+	return answers
+}
+
+// quorumNotReached returns the error of a quorum read which got too few answers
+func quorumNotReached(valid int, quorum int, lastErr error) error {
+	if lastErr != nil {
+		return fmt.Errorf("%w: %d of %d endpoints answered: %v", ErrQuorumNotReached, valid, quorum, lastErr)
+	}
+
+	return fmt.Errorf("%w: %d of %d endpoints answered", ErrQuorumNotReached, valid, quorum)
+}
+
+// quorumRead runs the read on every endpoint and returns the answer shared
+// by at least quorum of them. Answers are compared by the key returned along
+// with them.
+func quorumRead[T any](ctx context.Context, clients []*ethclient.Client, quorum int, read func(context.Context, *ethclient.Client) (T, string, error)) (T, error) {
+	var (
+		zero    T
+		lastErr error
+		valid   int
+	)
+
+	votes := make(map[string]int)
+
+	for _, a := range readEndpoints(ctx, clients, read) {
+		if a.err != nil {
+			lastErr = a.err
+			continue
+		}
+
+		valid++
+		votes[a.key]++
+
+		if votes[a.key] >= quorum {
+			return a.value, nil
+		}
+	}
+
+	if valid >= quorum {
+		return zero, ErrQuorumMismatch
+	}
+
+	return zero, quorumNotReached(valid, quorum, lastErr)
+}
+
+// quorumMinRead runs the read on every endpoint and returns the answer with
+// the highest number reached by at least quorum of them, that is the lowest
+// number of the quorum. Endpoints lagging behind don't fail the read as long
+// as enough of them answered.
+func quorumMinRead[T any](ctx context.Context, clients []*ethclient.Client, quorum int, read func(context.Context, *ethclient.Client) (T, uint64, error)) (T, error) {
+	type numbered struct {
+		value  T
+		number uint64
+	}
+
+	answers := readEndpoints(ctx, clients, func(ctx context.Context, client *ethclient.Client) (numbered, string, error) {
+		value, number, err := read(ctx, client)
+		return numbered{value: value, number: number}, "", err
+	})
+
+	var (
+		zero    T
+		lastErr error
+	)
+
+	valid := make([]numbered, 0, len(answers))
+
+	for _, a := range answers {
+		if a.err != nil {
+			lastErr = a.err
+			continue
+		}
+
+		valid = append(valid, a.value)
+	}
+
+	if quorum < 1 {
+		quorum = 1
+	}
+
+	if len(valid) < quorum {
+		return zero, quorumNotReached(len(valid), quorum, lastErr)
+	}
+
+	// highest numbers first
+	sort.Slice(valid, func(i, j int) bool {
+		return valid[i].number > valid[j].number
+	})
+
+	return valid[quorum-1].value, nil
+}
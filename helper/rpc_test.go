@@ -0,0 +1,159 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRPCServer answers eth_blockNumber with the given head, or with the
+// given http status if not 200
+func newTestRPCServer(t *testing.T, head uint64, status int, calls *int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, req.ID, head)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRPCEndpointsFailover(t *testing.T) {
+	t.Parallel()
+
+	var downCalls, upCalls int32
+
+	down := newTestRPCServer(t, 0, http.StatusTooManyRequests, &downCalls)
+	up := newTestRPCServer(t, 100, http.StatusOK, &upCalls)
+
+	endpoints, err := NewRPCEndpoints("eth", []string{down.URL, up.URL})
+	require.NoError(t, err)
+
+	rpcClient, err := endpoints.Dial()
+	require.NoError(t, err)
+
+	client := ethclient.NewClient(rpcClient)
+
+	head, err := client.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), head)
+	require.Equal(t, int32(1), atomic.LoadInt32(&downCalls))
+
+	// the failed endpoint is skipped until it is healthy again
+	head, err = client.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), head)
+	require.Equal(t, int32(1), atomic.LoadInt32(&downCalls))
+	require.Equal(t, int32(2), atomic.LoadInt32(&upCalls))
+	require.False(t, endpoints.endpoints[0].isHealthy())
+}
+
+func TestRPCEndpointsCheckHealth(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	lagging := newTestRPCServer(t, 100, http.StatusOK, &calls)
+	synced := newTestRPCServer(t, 100+rpcMaxBlockLag+1, http.StatusOK, &calls)
+	down := newTestRPCServer(t, 0, http.StatusBadGateway, &calls)
+
+	endpoints, err := NewRPCEndpoints("bor", []string{lagging.URL, synced.URL, down.URL})
+	require.NoError(t, err)
+
+	endpoints.CheckHealth(context.Background(), time.Second)
+
+	require.False(t, endpoints.endpoints[0].isHealthy())
+	require.True(t, endpoints.endpoints[1].isHealthy())
+	require.False(t, endpoints.endpoints[2].isHealthy())
+
+	// healthy endpoints come first
+	require.Equal(t, endpoints.endpoints[1], endpoints.ordered()[0])
+}
+
+func TestQuorumRead(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	endpoints, err := NewRPCEndpoints("eth", []string{
+		newTestRPCServer(t, 10, http.StatusOK, &calls).URL,
+		newTestRPCServer(t, 10, http.StatusOK, &calls).URL,
+		newTestRPCServer(t, 11, http.StatusOK, &calls).URL,
+		newTestRPCServer(t, 0, http.StatusInternalServerError, &calls).URL,
+	})
+	require.NoError(t, err)
+
+	read := func(ctx context.Context, client *ethclient.Client) (uint64, string, error) {
+		head, err := client.BlockNumber(ctx)
+		return head, fmt.Sprint(head), err
+	}
+
+	head, err := quorumRead(context.Background(), endpoints.Clients(), 2, read)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), head)
+
+	_, err = quorumRead(context.Background(), endpoints.Clients(), 3, read)
+	require.True(t, errors.Is(err, ErrQuorumMismatch))
+
+	_, err = quorumRead(context.Background(), endpoints.Clients(), 4, read)
+	require.True(t, errors.Is(err, ErrQuorumNotReached))
+}
+
+func TestQuorumMinRead(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	endpoints, err := NewRPCEndpoints("eth", []string{
+		newTestRPCServer(t, 12, http.StatusOK, &calls).URL,
+		newTestRPCServer(t, 10, http.StatusOK, &calls).URL,
+		newTestRPCServer(t, 11, http.StatusOK, &calls).URL,
+		newTestRPCServer(t, 0, http.StatusInternalServerError, &calls).URL,
+	})
+	require.NoError(t, err)
+
+	read := func(ctx context.Context, client *ethclient.Client) (uint64, uint64, error) {
+		head, err := client.BlockNumber(ctx)
+		return head, head, err
+	}
+
+	// lagging endpoints don't fail the read, the lowest head of the quorum is used
+	head, err := quorumMinRead(context.Background(), endpoints.Clients(), 2, read)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), head)
+
+	head, err = quorumMinRead(context.Background(), endpoints.Clients(), 3, read)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), head)
+
+	_, err = quorumMinRead(context.Background(), endpoints.Clients(), 4, read)
+	require.True(t, errors.Is(err, ErrQuorumNotReached))
+}
@@ -0,0 +1,272 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+const (
+	// paths of the remote signer api
+	signerPubKeyPath = "/pubkey"
+	signerSignPath   = "/sign"
+
+	// max body size of the remote signer requests and responses
+	signerMaxBodySize = 1 << 16
+)
+
+// Signer signs with the validator key. The same key signs heimdall txs and
+// main chain txs, both over a keccak256 hash.
+type Signer interface {
+	// PubKey returns the uncompressed public key of the validator
+	PubKey() secp256k1.PubKeySecp256k1
+
+	// SignHash returns the 65 bytes [R || S || V] signature of the 32 bytes hash
+	SignHash(hash []byte) ([]byte, error)
+}
+
+// unavailableSigner fails the signatures when the validator key can not be loaded
+type unavailableSigner struct {
+	err error
+}
+
+func (s unavailableSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return secp256k1.PubKeySecp256k1{}
+}
+
+func (s unavailableSigner) SignHash(_ []byte) ([]byte, error) {
+	return nil, s.err
+}
+
+// signerPubKeyResponse is the remote signer answer to a public key request
+type signerPubKeyResponse struct {
+	PubKey hexutil.Bytes `json:"pubkey"`
+}
+
+// signerSignRequest is a remote signer signature request
+type signerSignRequest struct {
+	Hash hexutil.Bytes `json:"hash"`
+}
+
+// signerSignResponse is the remote signer answer to a signature request
+type signerSignResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// localSigner signs with the key loaded from the priv validator file
+type localSigner struct {
+	privKey secp256k1.PrivKeySecp256k1
+}
+
+// NewLocalSigner returns a signer using the given private key
+func NewLocalSigner(privKey secp256k1.PrivKeySecp256k1) Signer {
+	return &localSigner{privKey: privKey}
+}
+
+func (s *localSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	pubKey, _ := s.privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	return pubKey
+}
+
+func (s *localSigner) SignHash(hash []byte) ([]byte, error) {
+	privKey, err := ethCrypto.ToECDSA(s.privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return ethCrypto.Sign(hash, privKey)
+}
+
+// remoteSigner asks a remote signer service over http to sign, so the
+// validator key never lives in the node process. The service answers
+// GET /pubkey with the public key of the validator, and POST /sign with
+// the signature of the given hash.
+type remoteSigner struct {
+	url        string
+	httpClient *http.Client
+	pubKey     secp256k1.PubKeySecp256k1
+}
+
+// NewRemoteSigner returns a signer using the remote signer service at url.
+// It fetches the validator public key from the service.
+func NewRemoteSigner(url string, timeout time.Duration) (Signer, error) {
+	s := &remoteSigner{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+
+	var res signerPubKeyResponse
+	if err := s.call(http.MethodGet, signerPubKeyPath, nil, &res); err != nil {
+		return nil, fmt.Errorf("unable to fetch the public key from the remote signer: %w", err)
+	}
+
+	if _, err := ethCrypto.UnmarshalPubkey(res.PubKey); err != nil {
+		return nil, fmt.Errorf("invalid public key from the remote signer: %w", err)
+	}
+
+	copy(s.pubKey[:], res.PubKey)
+
+	return s, nil
+}
+
+func (s *remoteSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+func (s *remoteSigner) SignHash(hash []byte) ([]byte, error) {
+	var res signerSignResponse
+	if err := s.call(http.MethodPost, signerSignPath, &signerSignRequest{Hash: hash}, &res); err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign: %w", err)
+	}
+
+	// never hand out a signature of another key or hash
+	pubKey, err := ethCrypto.Ecrecover(hash, res.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from the remote signer: %w", err)
+	}
+
+	if !bytes.Equal(pubKey, s.pubKey[:]) {
+		return nil, errors.New("remote signer signed with another key")
+	}
+
+	return res.Signature, nil
+}
+
+func (s *remoteSigner) call(method string, path string, req interface{}, res interface{}) error {
+	var body io.Reader
+
+	if req != nil {
+		data, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(context.Background(), method, s.url+path, body)
+	if err != nil {
+		return err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, signerMaxBodySize))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%v: %s", resp.Status, bytes.TrimSpace(data))
+	}
+
+	return json.Unmarshal(data, res)
+}
+
+// NewSignerHandler serves the remote signer api with the given signer. Run
+// with a local signer, it is a signer service for tests and local setups.
+func NewSignerHandler(signer Signer) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(signerPubKeyPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		pubKey := signer.PubKey()
+		writeSignerResponse(w, &signerPubKeyResponse{PubKey: pubKey[:]})
+	})
+
+	mux.HandleFunc(signerSignPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req signerSignRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, signerMaxBodySize)).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if len(req.Hash) != common.HashLength {
+			http.Error(w, fmt.Sprintf("hash must be %d bytes", common.HashLength), http.StatusBadRequest)
+			return
+		}
+
+		sig, err := signer.SignHash(req.Hash)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeSignerResponse(w, &signerSignResponse{Signature: sig})
+	})
+
+	return mux
+}
+
+func writeSignerResponse(w http.ResponseWriter, res interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		Logger.Error("Unable to write signer response", "error", err)
+	}
+}
+
+// SignerAddress returns the ethereum address of the signer key
+func SignerAddress(signer Signer) common.Address {
+	pubKey := signer.PubKey()
+	return common.BytesToAddress(pubKey.Address().Bytes())
+}
+
+// NewSignerTransactor returns the transact options of main chain txs signed
+// by the signer
+func NewSignerTransactor(signer Signer, chainID *big.Int) *bind.TransactOpts {
+	from := SignerAddress(signer)
+
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			return SignTx(signer, tx, chainID)
+		},
+		Context: context.Background(),
+	}
+}
+
+// SignTx signs the main chain tx with the signer
+func SignTx(signer Signer, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	txSigner := types.LatestSignerForChainID(chainID)
+
+	sig, err := signer.SignHash(txSigner.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.WithSignature(txSigner, sig)
+}
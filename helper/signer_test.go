@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+)
+
+// mismatchedSigner announces a key but signs with another one
+type mismatchedSigner struct {
+	announced Signer
+	signing   Signer
+}
+
+func (s *mismatchedSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.announced.PubKey()
+}
+
+func (s *mismatchedSigner) SignHash(hash []byte) ([]byte, error) {
+	return s.signing.SignHash(hash)
+}
+
+func newTestRemoteSigner(t *testing.T, service Signer) Signer {
+	t.Helper()
+
+	server := httptest.NewServer(NewSignerHandler(service))
+	t.Cleanup(server.Close)
+
+	remote, err := NewRemoteSigner(server.URL, time.Second)
+	require.NoError(t, err)
+
+	return remote
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.GenPrivKey()
+	local := NewLocalSigner(privKey)
+	remote := newTestRemoteSigner(t, local)
+
+	require.Equal(t, local.PubKey(), remote.PubKey())
+	require.Equal(t, privKey.PubKey(), remote.PubKey())
+
+	// heimdall txs get the same signature as with the private key
+	signMsg := authTypes.StdSignMsg{ChainID: "heimdall-test", AccountNumber: 1, Sequence: 2, Msg: sdk.NewTestMsg()}
+
+	sig, err := authTypes.MakeSignatureWithSigner(remote, signMsg)
+	require.NoError(t, err)
+
+	expected, err := authTypes.MakeSignature(privKey, signMsg)
+	require.NoError(t, err)
+	require.Equal(t, expected, sig)
+
+	// main chain txs are signed by the validator address
+	to := common.HexToAddress("0x10")
+	chainID := big.NewInt(5)
+
+	opts := NewSignerTransactor(remote, chainID)
+	require.Equal(t, SignerAddress(local), opts.From)
+
+	tx, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{ChainID: chainID, To: &to, Gas: 21000, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2)}))
+	require.NoError(t, err)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	require.NoError(t, err)
+	require.Equal(t, opts.From, sender)
+}
+
+func TestRemoteSignerRejectsOtherKey(t *testing.T) {
+	t.Parallel()
+
+	remote := newTestRemoteSigner(t, &mismatchedSigner{
+		announced: NewLocalSigner(secp256k1.GenPrivKey()),
+		signing:   NewLocalSigner(secp256k1.GenPrivKey()),
+	})
+
+	_, err := remote.SignHash(common.HexToHash("0x01").Bytes())
+	require.Error(t, err)
+
+	// the service only signs hashes
+	_, err = remote.SignHash([]byte("not a hash"))
+	require.Error(t, err)
+}
+
+func TestLoadValidatorKeyRemoteSigner(t *testing.T) {
+	prevConf, prevSigner, prevPub := conf, signer, pubObject
+
+	t.Cleanup(func() {
+		conf, signer, pubObject = prevConf, prevSigner, prevPub
+		validatorKeyLoaded = false
+	})
+
+	local := NewLocalSigner(secp256k1.GenPrivKey())
+
+	server := httptest.NewUnstartedServer(NewSignerHandler(local))
+	t.Cleanup(server.Close)
+
+	conf.RemoteSignerURL = "http://" + server.Listener.Addr().String()
+	conf.RemoteSignerTimeout = 200 * time.Millisecond
+	validatorKeyLoaded = false
+
+	// an unreachable signer fails the signatures, not the process
+	_, err := GetSigner().SignHash(make([]byte, 32))
+	require.Error(t, err)
+	require.Error(t, LoadValidatorKey())
+
+	// the signer is connected once reachable
+	server.Start()
+
+	require.NoError(t, LoadValidatorKey())
+	require.Equal(t, local.PubKey(), GetPubKey())
+	require.Equal(t, local.PubKey(), GetSigner().PubKey())
+}
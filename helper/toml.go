@@ -20,10 +20,21 @@ eth_rpc_url = "{{ .EthRPCUrl }}"
 # RPC endpoint for bor chain
 bor_rpc_url = "{{ .BorRPCUrl }}"
 
+# RPC endpoints tried in order when the ethereum or bor endpoint fails (optional, http only)
+eth_rpc_fallback_urls = [{{ range $i, $url := .EthRPCFallbackUrls }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+bor_rpc_fallback_urls = [{{ range $i, $url := .BorRPCFallbackUrls }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+rpc_health_check_interval = "{{ .RPCHealthCheckInterval }}"
+
+# Number of ethereum endpoints which must return the same tx receipts and
+# have reached the finalized block, 0 to read them from a single endpoint. The
+# finalized block is the lowest one of the quorum. Side-tx votes are skipped
+# when the endpoints disagree on a receipt or its logs.
+eth_rpc_quorum = {{ .EthRPCQuorum }}
+
 # RPC endpoint for tendermint
 tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 
-# Polygon Sub Graph URL for self-heal mechanism (optional)
+# Polygon Sub Graph URL for self-heal mechanism (optional, the L1 logs are scanned otherwise)
 sub_graph_url = "{{ .SubGraphUrl }}"
 
 #### Bridge configs ####
@@ -34,6 +45,9 @@ heimdall_rest_server = "{{ .HeimdallServerURL }}"
 # AMQP endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# Task queue backend, "amqp" or "leveldb" to keep the tasks in the bridge db
+queue_backend = "{{ .QueueBackend }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
@@ -45,7 +59,29 @@ enable_self_heal = "{{ .EnableSH }}"
 sh_state_synced_interval = "{{ .SHStateSyncedInterval }}"
 sh_stake_update_interval = "{{ .SHStakeUpdateInterval }}"
 sh_max_depth_duration = "{{ .SHMaxDepthDuration }}"
-
+sh_log_scan_range = "{{ .SHLogScanRange }}"
+sh_log_scan_start_block = "{{ .SHLogScanStartBlock }}"
+
+## Listener subscriptions
+## the rootchain and maticchain listeners subscribe to new blocks through the
+## websocket endpoints if set, the heimdall listener through tendermint. They
+## poll while a subscription is down or got no block for listener_watchdog_timeout.
+eth_ws_url = "{{ .EthWSUrl }}"
+bor_ws_url = "{{ .BorWSUrl }}"
+listener_watchdog_timeout = "{{ .ListenerWatchdogTimeout }}"
+
+
+#### Remote signer ####
+## url of a remote signer service holding the validator key, which then signs
+## the heimdall and main chain txs. The priv validator file is used if empty.
+remote_signer_url = "{{ .RemoteSignerURL }}"
+remote_signer_timeout = "{{ .RemoteSignerTimeout }}"
+
+#### Keystore ####
+## passphrase protected keystore holding the validator key, used instead of the
+## priv validator file. The passphrase is prompted for without password file.
+keystore_file = "{{ .KeystoreFile }}"
+keystore_password_file = "{{ .KeystorePasswordFile }}"
 
 #### gas limits ####
 main_chain_gas_limit = "{{ .MainchainGasLimit }}"
@@ -53,9 +89,45 @@ main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 #### gas price ####
 main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
 
+## max priority fee and max fee per gas of dynamic fee (EIP-1559) transactions
+main_chain_max_gas_tip_cap = "{{ .MainchainMaxGasTipCap }}"
+main_chain_max_gas_fee_cap = "{{ .MainchainMaxGasFeeCap }}"
+
+## pending checkpoint and tick transactions are replaced with fees bumped by
+## main_chain_tx_bump_percent (at least 10) after main_chain_tx_bump_timeout
+main_chain_tx_bump_timeout = "{{ .MainchainTxBumpTimeout }}"
+main_chain_tx_bump_percent = "{{ .MainchainTxBumpPercent }}"
+
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+##### Backup checkpoint submission #####
+## the next backup_submitters validators in proposer order submit a checkpoint
+## to rootchain if it is still missing, each backup_submitter_delay after the
+## previous one. 0 disables it.
+backup_submitters = {{ .BackupSubmitters }}
+backup_submitter_delay = "{{ .BackupSubmitterDelay }}"
+
+##### Heimdall tx batching #####
+## up to heimdall_tx_batch_size compatible msgs without side-tx handler (bounded by the auth max_tx_msgs
+## param) are broadcast in a single tx, within the max bytes and gas of a batch.
+## A batch waits heimdall_tx_batch_wait for more msgs. 1 disables batching.
+heimdall_tx_batch_size = {{ .HeimdallTxBatchSize }}
+heimdall_tx_batch_max_bytes = {{ .HeimdallTxBatchMaxBytes }}
+heimdall_tx_batch_max_gas = {{ .HeimdallTxBatchMaxGas }}
+heimdall_tx_batch_wait = "{{ .HeimdallTxBatchWait }}"
+
+##### Bridge high availability #####
+## only the bridge instance holding the lease runs, the others wait on standby
+## and resume from the bridge db once it expires. "file" keeps the lease in
+## bridge_lease_path (defaults next to the bridge db), renewed within
+## bridge_lease_ttl. "tcp" holds it by listening on bridge_lease_path
+## (defaults to 127.0.0.1:26690), so it only works for the instances of a
+## single host. Empty disables it.
+bridge_lease_backend = "{{ .BridgeLeaseBackend }}"
+bridge_lease_path = "{{ .BridgeLeasePath }}"
+bridge_lease_ttl = "{{ .BridgeLeaseTTL }}"
+
 ##### chain - newSelectionAlgoHeight depends on this #####
 chain = "{{ .Chain }}"
 `
@@ -10,7 +10,7 @@ import (
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/maticnetwork/heimdall/contracts/erc20"
@@ -26,36 +26,17 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 		Data: data,
 	}
 
-	// get priv key
-	pkObject := GetPrivKey()
-
-	// create ecdsa private key
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
-	if err != nil {
-		return
-	}
+	// get the local or remote signer
+	signer := GetSigner()
 
 	// from address
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
-	// fetch gas price
-	gasprice, err := client.SuggestGasPrice(context.Background())
+	fromAddress := SignerAddress(signer)
+	// fetch gas price, or tip and fee caps on chains with a base fee
+	gasprice, gasTipCap, gasFeeCap, err := SuggestFees(context.Background(), client)
 	if err != nil {
 		return
 	}
 
-	mainChainMaxGasPrice := GetConfig().MainchainMaxGasPrice
-	// Check if configured or not, Use default in case of invalid value
-	if mainChainMaxGasPrice <= 0 {
-		mainChainMaxGasPrice = DefaultMainchainMaxGasPrice
-	}
-
-	if gasprice.Cmp(big.NewInt(mainChainMaxGasPrice)) == 1 {
-		Logger.Error("Gas price is more than max gas price", "gasprice", gasprice)
-		err = fmt.Errorf("gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %d", gasprice, mainChainMaxGasPrice)
-
-		return
-	}
-
 	nonce, err := client.NonceAt(context.Background(), fromAddress, nil)
 	if err != nil {
 		return
@@ -73,32 +54,106 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	}
 
 	// create auth
-	auth, err = bind.NewKeyedTransactorWithChainID(ecdsaPrivateKey, chainId)
-	if err != nil {
-		Logger.Error("Unable to create auth object", "error", err)
-		return
-	}
+	auth = NewSignerTransactor(signer, chainId)
 
 	auth.GasPrice = gasprice
+	auth.GasTipCap = gasTipCap
+	auth.GasFeeCap = gasFeeCap
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.GasLimit = gasLimit
 
 	return
 }
 
+// feeSuggester is the part of the eth client suggesting transaction fees
+type feeSuggester interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// GetMainchainMaxFees returns the max gas price of legacy transactions and
+// the max tip and fee cap of dynamic fee transactions, falling back to the
+// defaults when not configured
+func GetMainchainMaxFees() (maxGasPrice *big.Int, maxGasTipCap *big.Int, maxGasFeeCap *big.Int) {
+	maxGasPrice = big.NewInt(GetConfig().MainchainMaxGasPrice)
+	if maxGasPrice.Sign() <= 0 {
+		maxGasPrice = big.NewInt(DefaultMainchainMaxGasPrice)
+	}
+
+	maxGasTipCap = big.NewInt(GetConfig().MainchainMaxGasTipCap)
+	if maxGasTipCap.Sign() <= 0 {
+		maxGasTipCap = big.NewInt(DefaultMainchainMaxGasTipCap)
+	}
+
+	maxGasFeeCap = big.NewInt(GetConfig().MainchainMaxGasFeeCap)
+	if maxGasFeeCap.Sign() <= 0 {
+		maxGasFeeCap = big.NewInt(DefaultMainchainMaxGasFeeCap)
+	}
+
+	return maxGasPrice, maxGasTipCap, maxGasFeeCap
+}
+
+// SuggestFees returns the fees of a new transaction. On chains with a base
+// fee, it returns the tip and fee caps of a dynamic fee transaction and a nil
+// gas price, otherwise it returns a legacy gas price only.
+func SuggestFees(ctx context.Context, client feeSuggester) (gasPrice *big.Int, gasTipCap *big.Int, gasFeeCap *big.Int, err error) {
+	maxGasPrice, maxGasTipCap, maxGasFeeCap := GetMainchainMaxFees()
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if head.BaseFee == nil {
+		if gasPrice, err = client.SuggestGasPrice(ctx); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if gasPrice.Cmp(maxGasPrice) == 1 {
+			Logger.Error("Gas price is more than max gas price", "gasprice", gasPrice)
+			return nil, nil, nil, fmt.Errorf("gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %v", gasPrice, maxGasPrice)
+		}
+
+		return gasPrice, nil, nil, nil
+	}
+
+	if gasTipCap, err = client.SuggestGasTipCap(ctx); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if gasTipCap.Cmp(maxGasTipCap) == 1 {
+		gasTipCap = maxGasTipCap
+	}
+
+	// the transaction can't be included while the base fee is this high
+	if minFeeCap := new(big.Int).Add(head.BaseFee, gasTipCap); minFeeCap.Cmp(maxGasFeeCap) == 1 {
+		Logger.Error("Base fee is more than max fee cap", "baseFee", head.BaseFee, "gasTipCap", gasTipCap)
+		return nil, nil, nil, fmt.Errorf("base fee and tip are more than max_gas_fee_cap, baseFee = %v, gasTipCap = %v, maxGasFeeCap = %v", head.BaseFee, gasTipCap, maxGasFeeCap)
+	}
+
+	// leave room for the base fee to double before the transaction is included
+	gasFeeCap = new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), gasTipCap)
+	if gasFeeCap.Cmp(maxGasFeeCap) == 1 {
+		gasFeeCap = maxGasFeeCap
+	}
+
+	return nil, gasTipCap, gasFeeCap, nil
+}
+
 // SendCheckpoint sends checkpoint to rootchain contract
 // todo return err
-func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (er error) {
+func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (*types.Transaction, error) {
 	data, err := c.RootChainABI.Pack("submitCheckpoint", signedData, sigs)
 	if err != nil {
 		Logger.Error("Unable to pack tx for submitCheckpoint", "error", err)
-		return err
+		return nil, err
 	}
 
 	auth, err := GenerateAuthObj(GetMainClient(), rootChainAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
-		return err
+		return nil, err
 	}
 
 	s := make([]string, 0)
@@ -114,26 +169,26 @@ func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, r
 	tx, err := rootChainInstance.SubmitCheckpoint(auth, signedData, sigs)
 	if err != nil {
 		Logger.Error("Error while submitting checkpoint", "error", err)
-		return err
+		return nil, err
 	}
 
 	Logger.Info("Submitted new checkpoint to rootchain successfully", "txHash", tx.Hash().String())
 
-	return
+	return tx, nil
 }
 
 // SendTick sends slash tick to rootchain contract
-func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (er error) {
+func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (*types.Transaction, error) {
 	data, err := c.SlashManagerABI.Pack("updateSlashedAmounts", signedData, sigs)
 	if err != nil {
 		Logger.Error("Unable to pack tx for updateSlashedAmounts", "error", err)
-		return err
+		return nil, err
 	}
 
 	auth, err := GenerateAuthObj(GetMainClient(), slashManagerAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
-		return err
+		return nil, err
 	}
 
 	Logger.Info("Sending new tick",
@@ -144,12 +199,12 @@ func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAd
 	tx, err := slashManagerInstance.UpdateSlashedAmounts(auth, signedData, sigs)
 	if err != nil {
 		Logger.Error("Error while submitting tick", "error", err)
-		return err
+		return nil, err
 	}
 
 	Logger.Info("Submitted new tick to slashmanager successfully", "txHash", tx.Hash().String())
 
-	return
+	return tx, nil
 }
 
 // StakeFor stakes for a validator
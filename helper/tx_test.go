@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFeeSuggester suggests fixed fees
+type fakeFeeSuggester struct {
+	baseFee  *big.Int
+	gasPrice *big.Int
+	tip      *big.Int
+}
+
+func (f *fakeFeeSuggester) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: f.baseFee}, nil
+}
+
+func (f *fakeFeeSuggester) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return f.gasPrice, nil
+}
+
+func (f *fakeFeeSuggester) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return f.tip, nil
+}
+
+func TestSuggestFees(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	gwei := int64(1000000000)
+
+	// dynamic fees on chains with a base fee
+	gasPrice, tip, feeCap, err := SuggestFees(ctx, &fakeFeeSuggester{baseFee: big.NewInt(30 * gwei), tip: big.NewInt(2 * gwei)})
+	require.NoError(t, err)
+	require.Nil(t, gasPrice)
+	require.Equal(t, big.NewInt(2*gwei), tip)
+	require.Equal(t, big.NewInt(62*gwei), feeCap)
+
+	// tip and fee caps are capped
+	_, tip, feeCap, err = SuggestFees(ctx, &fakeFeeSuggester{baseFee: big.NewInt(300 * gwei), tip: big.NewInt(80 * gwei)})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(DefaultMainchainMaxGasTipCap), tip)
+	require.Equal(t, big.NewInt(DefaultMainchainMaxGasFeeCap), feeCap)
+
+	// no tx can be included above the max fee cap
+	_, _, _, err = SuggestFees(ctx, &fakeFeeSuggester{baseFee: big.NewInt(400 * gwei), tip: big.NewInt(2 * gwei)})
+	require.Error(t, err)
+
+	// legacy gas price without base fee
+	gasPrice, tip, feeCap, err = SuggestFees(ctx, &fakeFeeSuggester{gasPrice: big.NewInt(40 * gwei)})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(40*gwei), gasPrice)
+	require.Nil(t, tip)
+	require.Nil(t, feeCap)
+
+	_, _, _, err = SuggestFees(ctx, &fakeFeeSuggester{gasPrice: big.NewInt(DefaultMainchainMaxGasPrice + 1)})
+	require.Error(t, err)
+}
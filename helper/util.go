@@ -357,7 +357,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext, txBldr authTypes.TxBuilder, msg
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	if !cliCtx.SkipConfirm {
@@ -404,7 +404,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -539,7 +539,7 @@ func SignStdTx(cliCtx context.CLIContext, stdTx authTypes.StdTx, appendSig bool,
 		return txBldr.SignStdTxWithPassphrase(fromName, passphrase, stdTx, appendSig)
 	}
 
-	return txBldr.SignStdTx(GetPrivKey(), stdTx, appendSig)
+	return txBldr.SignStdTxWithSigner(GetSigner(), stdTx, appendSig)
 }
 
 // ReadStdTxFromFile and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
@@ -38,5 +38,14 @@ func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p types.ParameterC
 		}
 	}
 
+	// validate once all the changes are set, as they may depend on each other
+	for _, c := range p.Changes {
+		ss, _ := k.GetSubspace(c.Subspace)
+
+		if err := ss.Validate(ctx, []byte(c.Key)); err != nil {
+			return types.ErrSettingParameter(k.codespace, c.Key, c.Value, err.Error())
+		}
+	}
+
 	return nil
 }
@@ -13,3 +13,10 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// ValidatedParamSet is a ParamSet whose parameters are validated together
+// when a proposal changes any of them
+type ValidatedParamSet interface {
+	ParamSet
+	Validate() error
+}
@@ -185,6 +185,28 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	return nil
 }
 
+// Validate validates the param set of the parameter, if it was registered
+// with RegisterValidatedParamSet
+func (s Subspace) Validate(ctx sdk.Context, key []byte) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		panic("Parameter not registered")
+	}
+
+	if attr.set == nil {
+		return nil
+	}
+
+	// start from a copy of the defaults
+	value := reflect.New(reflect.TypeOf(attr.set).Elem())
+	value.Elem().Set(reflect.ValueOf(attr.set).Elem())
+
+	ps := value.Interface().(ValidatedParamSet)
+	s.GetParamSetIfExists(ctx, ps)
+
+	return ps.Validate()
+}
+
 // Get to ParamSet
 func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
@@ -192,6 +214,14 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
+// GetParamSetIfExists to ParamSet, keeping the values of the parameters not
+// set in the store, like parameters added after genesis
+func (s Subspace) GetParamSetIfExists(ctx sdk.Context, ps ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+}
+
 // Set from ParamSet
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
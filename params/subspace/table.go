@@ -6,6 +6,9 @@ import (
 
 type attribute struct {
 	ty reflect.Type
+
+	// defaults of the param set validated along with the parameter, if any
+	set ValidatedParamSet
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -80,6 +83,20 @@ func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	return t
 }
 
+// RegisterValidatedParamSet registers the pairs of a ParamSet validated as a
+// whole on changes, ps holds the values of the parameters not set in the store
+func (t KeyTable) RegisterValidatedParamSet(ps ValidatedParamSet) KeyTable {
+	t = t.RegisterParamSet(ps)
+
+	for _, kvp := range ps.ParamSetPairs() {
+		attr := t.m[string(kvp.Key)]
+		attr.set = ps
+		t.m[string(kvp.Key)] = attr
+	}
+
+	return t
+}
+
 func (t KeyTable) maxKeyLength() (res int) {
 	for k := range t.m {
 		l := len(k)
@@ -2,90 +2,199 @@ package gRPC
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
-	cliContext "github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/maticnetwork/heimdall/helper"
-	hmTypes "github.com/maticnetwork/heimdall/types"
-
+	"github.com/ethereum/go-ethereum/common"
+	jsoniter "github.com/json-iterator/go"
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	protoutils "github.com/maticnetwork/polyproto/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
-)
 
-type Checkpoint struct {
-	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
-	StartBlock uint64                  `json:"start_block"`
-	EndBlock   uint64                  `json:"end_block"`
-	RootHash   hmTypes.HeimdallHash    `json:"root_hash"`
-	BorChainID string                  `json:"bor_chain_id"`
-	TimeStamp  uint64                  `json:"timestamp"`
-}
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/server/gRPC/pb"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
 
 func (h *HeimdallGRPCServer) FetchCheckpointCount(ctx context.Context, in *emptypb.Empty) (*proto.FetchCheckpointCountResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
-
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchCheckpointCount))
+	ackCount, height, err := h.fetchAckCount(ctx)
 	if err != nil {
-		logger.Error("Error while fetching checkpoint count")
+		logger.Error("Error while fetching checkpoint count", "error", err)
 		return nil, err
 	}
 
 	resp := &proto.FetchCheckpointCountResponse{}
-	resp.Height = fmt.Sprint(result.Height)
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &proto.CheckpointCount{Result: int64(ackCount)}
+
+	return resp, nil
+}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
-		logger.Error("Error unmarshalling checkpoint count", "error", err)
+func (h *HeimdallGRPCServer) FetchCheckpoint(ctx context.Context, in *proto.FetchCheckpointRequest) (*proto.FetchCheckpointResponse, error) {
+	ackCount, _, err := h.fetchAckCount(ctx)
+	if err != nil {
+		logger.Error("Error while fetching checkpoint count", "error", err)
 		return nil, err
 	}
 
+	number := ackCount
+	if in.ID != -1 {
+		number = uint64(in.ID)
+	}
+
+	if number == 0 || number > ackCount || in.ID < -1 {
+		return nil, status.Errorf(codes.NotFound, "checkpoint %v not found", in.ID)
+	}
+
+	checkPoint, height, err := h.fetchCheckpointByNumber(ctx, number)
+	if err != nil {
+		logger.Error("Error while fetching checkpoint", "number", number, "error", err)
+		return nil, err
+	}
+
+	resp := &proto.FetchCheckpointResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = parseCheckpoint(checkPoint)
+
 	return resp, nil
 }
 
-func (h *HeimdallGRPCServer) FetchCheckpoint(ctx context.Context, in *proto.FetchCheckpointRequest) (*proto.FetchCheckpointResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
-
-	url := ""
-	if in.ID == -1 {
-		url = fmt.Sprintf(fetchCheckpoint, "latest")
-	} else {
-		url = fmt.Sprintf(fetchCheckpoint, fmt.Sprint(in.ID))
+func (h *HeimdallGRPCServer) FetchExitProof(ctx context.Context, in *pb.FetchExitProofRequest) (*pb.FetchExitProofResponse, error) {
+	var txHash string
+	if in.TxHash != nil {
+		txHash = common.Hash(protoutils.ConvertH256ToHash(in.TxHash)).Hex()
 	}
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(url))
+	queryParams, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryExitProofParams(in.BlockNumber, txHash, in.LogIndex))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
+	result, height, err := h.query(ctx, checkpointTypes.QuerierRoute, checkpointTypes.QueryExitProof, queryParams)
 	if err != nil {
-		logger.Error("Error while fetching checkpoint")
+		logger.Error("Error while fetching exit proof", "block", in.BlockNumber, "txHash", txHash, "error", err)
 		return nil, err
 	}
 
-	checkPoint := &Checkpoint{}
-	if err := json.Unmarshal(result.Result, &checkPoint); err != nil {
-		logger.Error("Error unmarshalling checkpoint", "error", err)
+	var proof checkpointTypes.ExitProof
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &proof); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.FetchExitProofResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &pb.ExitProof{
+		CheckpointNumber:  proof.CheckpointNumber,
+		HeaderBlockNumber: proof.HeaderBlockNumber,
+		StartBlock:        proof.StartBlock,
+		EndBlock:          proof.EndBlock,
+		RootHash:          protoutils.ConvertHashToH256(proof.RootHash.EthHash()),
+		BlockNumber:       proof.BlockNumber,
+		BlockTime:         proof.BlockTime,
+		TxRoot:            protoutils.ConvertHashToH256(proof.TxRoot),
+		ReceiptRoot:       protoutils.ConvertHashToH256(proof.ReceiptRoot),
+		BlockProof:        proof.BlockProof,
+		Receipt:           proof.Receipt,
+		ReceiptProof:      proof.ReceiptProof,
+		ReceiptPath:       proof.ReceiptPath,
+		LogIndex:          proof.LogIndex,
+		Payload:           proof.Payload,
+	}
+
+	if proof.TxHash != nil {
+		resp.Result.TxHash = protoutils.ConvertHashToH256(*proof.TxHash)
+	}
+
+	return resp, nil
+}
+
+func (h *HeimdallGRPCServer) FetchBorBlockStatus(ctx context.Context, in *pb.FetchBorBlockStatusRequest) (*pb.FetchBorBlockStatusResponse, error) {
+	queryParams, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryBorBlockParams(in.BlockNumber))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, height, err := h.query(ctx, checkpointTypes.QuerierRoute, checkpointTypes.QueryBorBlockStatus, queryParams)
+	if err != nil {
+		logger.Error("Error while fetching bor block status", "block", in.BlockNumber, "error", err)
 		return nil, err
 	}
 
+	var blockStatus checkpointTypes.BorBlockStatus
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &blockStatus); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.FetchBorBlockStatusResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &pb.BorBlockStatus{
+		BlockNumber:      blockStatus.BlockNumber,
+		Checkpointed:     blockStatus.Checkpointed,
+		CheckpointNumber: blockStatus.CheckpointNumber,
+		MilestoneCovered: blockStatus.MilestoneCovered,
+	}
+
+	if blockStatus.Checkpoint != nil {
+		resp.Result.Checkpoint = parseCheckpoint(*blockStatus.Checkpoint)
+	}
+
+	return resp, nil
+}
+
+// fetchAckCount returns the number of acknowledged checkpoints
+func (h *HeimdallGRPCServer) fetchAckCount(ctx context.Context) (uint64, int64, error) {
+	result, height, err := h.query(ctx, checkpointTypes.QuerierRoute, checkpointTypes.QueryAckCount, nil)
+	if err != nil {
+		return 0, height, err
+	}
+
+	var ackCount uint64
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &ackCount); err != nil {
+		return 0, height, status.Error(codes.Internal, err.Error())
+	}
+
+	return ackCount, height, nil
+}
+
+// fetchCheckpointByNumber returns the acknowledged checkpoint with the given number
+func (h *HeimdallGRPCServer) fetchCheckpointByNumber(ctx context.Context, number uint64) (hmTypes.Checkpoint, int64, error) {
+	var checkpoint hmTypes.Checkpoint
+
+	queryParams, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryCheckpointParams(number))
+	if err != nil {
+		return checkpoint, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	result, height, err := h.query(ctx, checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpoint, queryParams)
+	if err != nil {
+		return checkpoint, height, err
+	}
+
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &checkpoint); err != nil {
+		return checkpoint, height, status.Error(codes.Internal, err.Error())
+	}
+
+	return checkpoint, height, nil
+}
+
+func parseCheckpoint(checkpoint hmTypes.Checkpoint) *proto.Checkpoint {
 	var hash [32]byte
 
-	copy(hash[:], checkPoint.RootHash.Bytes())
+	copy(hash[:], checkpoint.RootHash.Bytes())
 
 	var address [20]byte
 
-	copy(address[:], checkPoint.Proposer.Bytes())
+	copy(address[:], checkpoint.Proposer.Bytes())
 
-	resp := &proto.FetchCheckpointResponse{}
-	resp.Height = fmt.Sprint(result.Height)
-	resp.Result = &proto.Checkpoint{
-		StartBlock: checkPoint.StartBlock,
-		EndBlock:   checkPoint.EndBlock,
+	return &proto.Checkpoint{
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
 		RootHash:   protoutils.ConvertHashToH256(hash),
 		Proposer:   protoutils.ConvertAddressToH160(address),
-		Timestamp:  timestamppb.New(time.Unix(int64(checkPoint.TimeStamp), 0)),
-		BorChainID: checkPoint.BorChainID,
+		Timestamp:  timestamppb.New(time.Unix(int64(checkpoint.TimeStamp), 0)),
+		BorChainID: checkpoint.BorChainID,
 	}
-
-	return resp, nil
 }
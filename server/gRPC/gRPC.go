@@ -5,40 +5,46 @@ import (
 	"net"
 	"time"
 
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	tmLog "github.com/tendermint/tendermint/libs/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-)
 
-const (
-	spanURL                 = "/bor/span/%v"
-	eventRecordList         = "/clerk/event-record/list"
-	fetchCheckpointCount    = "/checkpoints/count"
-	fetchCheckpoint         = "/checkpoints/%s"
-	fetchMilestoneCount     = "/checkpoints/count"
-	fetchMilestone          = "/milestone/latest"
-	fetchMilestoneNoAck     = "/milestone/noAck/%s"
-	fetchLastNoAckMilestone = "/milestone/lastNoAck"
-	fetchMilestoneID        = "/milestone/ID/%s"
+	"github.com/maticnetwork/heimdall/server/gRPC/pb"
 )
 
 var logger tmLog.Logger
 
 type HeimdallGRPCServer struct {
 	proto.UnimplementedHeimdallServer
-	cdc *codec.Codec
+	pb.UnimplementedHeimdallSubscriptionServer
+	pb.UnimplementedHeimdallStakingServer
+	pb.UnimplementedHeimdallCheckpointServer
+	cdc    *codec.Codec
+	cliCtx cliContext.CLIContext
+	hub    *eventHub
 }
 
 func SetupGRPCServer(shutDownCtx context.Context, cdc *codec.Codec, addr string, lggr tmLog.Logger) error {
 	logger = lggr
-	grpcServer := grpc.NewServer(withLoggingUnaryInterceptor())
-	proto.RegisterHeimdallServer(grpcServer,
-		&HeimdallGRPCServer{
-			cdc: cdc,
-		})
+	grpcServer := grpc.NewServer(withLoggingUnaryInterceptor(), withLoggingStreamInterceptor())
+	server := &HeimdallGRPCServer{
+		cdc:    cdc,
+		cliCtx: cliContext.NewCLIContext().WithCodec(cdc),
+		hub:    newEventHub(),
+	}
+
+	proto.RegisterHeimdallServer(grpcServer, server)
+	pb.RegisterHeimdallSubscriptionServer(grpcServer, server)
+	pb.RegisterHeimdallStakingServer(grpcServer, server)
+	pb.RegisterHeimdallCheckpointServer(grpcServer, server)
+
+	if server.cliCtx.Client != nil {
+		go server.hub.run(shutDownCtx, server.cliCtx.Client)
+	}
 
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -68,12 +74,55 @@ func withLoggingUnaryInterceptor() grpc.ServerOption {
 func loggingServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	start := time.Now()
 
+	ctx, err := withQueryHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	h, err := handler(ctx, req)
 	if err != nil {
-		err = status.Errorf(codes.Internal, err.Error())
+		// handlers return typed status errors, anything else is unexpected
+		if _, ok := status.FromError(err); !ok {
+			err = status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	logger.Info("Request", "method", info.FullMethod, "duration", time.Since(start), "error", err)
 
 	return h, err
 }
+
+func withLoggingStreamInterceptor() grpc.ServerOption {
+	return grpc.StreamInterceptor(loggingStreamServerInterceptor)
+}
+
+func loggingStreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+
+	ctx, err := withQueryHeight(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	err = handler(srv, &heightServerStream{ServerStream: ss, ctx: ctx})
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			err = status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	logger.Info("Stream", "method", info.FullMethod, "duration", time.Since(start), "error", err)
+
+	return err
+}
+
+// heightServerStream overrides the stream context with the one carrying the
+// requested query height
+type heightServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *heightServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -2,150 +2,300 @@ package gRPC
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
-	cliContext "github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/maticnetwork/heimdall/helper"
-	hmTypes "github.com/maticnetwork/heimdall/types"
-
+	jsoniter "github.com/json-iterator/go"
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	protoutils "github.com/maticnetwork/polyproto/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
-)
 
-type Milestone struct {
-	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
-	StartBlock uint64                  `json:"start_block"`
-	EndBlock   uint64                  `json:"end_block"`
-	Hash       hmTypes.HeimdallHash    `json:"hash"`
-	BorChainID string                  `json:"bor_chain_id"`
-	TimeStamp  uint64                  `json:"timestamp"`
-}
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/server/gRPC/pb"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
 
 func (h *HeimdallGRPCServer) FetchMilestoneCount(ctx context.Context, in *emptypb.Empty) (*proto.FetchMilestoneCountResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
-
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchMilestoneCount))
+	count, height, err := h.fetchMilestoneCount(ctx)
 	if err != nil {
-		logger.Error("Error while fetching checkpoint count")
+		logger.Error("Error while fetching milestone count", "error", err)
 		return nil, err
 	}
 
 	resp := &proto.FetchMilestoneCountResponse{}
-	resp.Height = fmt.Sprint(result.Height)
-
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
-		logger.Error("Error unmarshalling milestone count", "error", err)
-		return nil, err
-	}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &proto.MilestoneCount{Count: int64(count)}
 
 	return resp, nil
 }
 
 func (h *HeimdallGRPCServer) FetchMilestone(ctx context.Context, in *emptypb.Empty) (*proto.FetchMilestoneResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
-
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchMilestone))
-
+	result, height, err := h.queryMilestone(ctx, checkpointTypes.QueryLatestMilestone, nil)
 	if err != nil {
-		logger.Error("Error while fetching milestone")
+		logger.Error("Error while fetching milestone", "error", err)
 		return nil, err
 	}
 
-	milestone := &Milestone{}
-	if err := json.Unmarshal(result.Result, &milestone); err != nil {
+	var milestone hmTypes.Milestone
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &milestone); err != nil {
 		logger.Error("Error unmarshalling milestone", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.FetchMilestoneResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = parseMilestone(milestone)
+
+	return resp, nil
+}
+
+func (h *HeimdallGRPCServer) FetchLastNoAckMilestone(ctx context.Context, in *emptypb.Empty) (*proto.FetchLastNoAckMilestoneResponse, error) {
+	result, height, err := h.queryMilestone(ctx, checkpointTypes.QueryLatestNoAckMilestone, nil)
+	if err != nil {
+		logger.Error("Error while fetching milestone last no ack", "error", err)
 		return nil, err
 	}
 
-	var hash [32]byte
+	var milestoneID string
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &milestoneID); err != nil {
+		logger.Error("Error unmarshalling milestone last no ack", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	copy(hash[:], milestone.Hash.Bytes())
+	resp := &proto.FetchLastNoAckMilestoneResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &proto.LastNoAckMilestone{Result: milestoneID}
 
-	var address [20]byte
+	return resp, nil
+}
 
-	copy(address[:], milestone.Proposer.Bytes())
+func (h *HeimdallGRPCServer) FetchNoAckMilestone(ctx context.Context, in *proto.FetchMilestoneNoAckRequest) (*proto.FetchMilestoneNoAckResponse, error) {
+	queryID, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryMilestoneID(in.MilestoneID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	resp := &proto.FetchMilestoneResponse{}
-	resp.Height = fmt.Sprint(result.Height)
-	resp.Result = &proto.Milestone{
-		StartBlock: milestone.StartBlock,
-		EndBlock:   milestone.EndBlock,
-		RootHash:   protoutils.ConvertHashToH256(hash),
-		Proposer:   protoutils.ConvertAddressToH160(address),
-		Timestamp:  timestamppb.New(time.Unix(int64(milestone.TimeStamp), 0)),
-		BorChainID: milestone.BorChainID,
+	result, height, err := h.queryMilestone(ctx, checkpointTypes.QueryNoAckMilestoneByID, queryID)
+	if err != nil {
+		logger.Error("Error while fetching milestone no ack", "error", err)
+		return nil, err
+	}
+
+	var val bool
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &val); err != nil {
+		logger.Error("Error unmarshalling milestone no ack", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	resp := &proto.FetchMilestoneNoAckResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &proto.MilestoneNoAck{Result: val}
+
 	return resp, nil
 }
 
-func (h *HeimdallGRPCServer) FetchLastNoAckMilestone(ctx context.Context, in *emptypb.Empty) (*proto.FetchLastNoAckMilestoneResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+func (h *HeimdallGRPCServer) FetchMilestoneID(ctx context.Context, in *proto.FetchMilestoneIDRequest) (*proto.FetchMilestoneIDResponse, error) {
+	// the milestone id in voting is only kept in memory of the node
+	if err := requireLatestHeight(ctx); err != nil {
+		return nil, err
+	}
+
+	resp := &proto.FetchMilestoneIDResponse{}
+	resp.Height = fmt.Sprint(0)
+	resp.Result = &proto.MilestoneID{Result: in.MilestoneID == checkpointTypes.GetMilestoneID()}
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchLastNoAckMilestone))
+	return resp, nil
+}
+
+func (h *HeimdallGRPCServer) FetchMilestoneList(ctx context.Context, in *pb.FetchMilestoneListRequest) (*pb.FetchMilestoneListResponse, error) {
+	queryParams, err := h.cdc.MarshalJSON(hmTypes.NewQueryPaginationParams(in.Page, in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
+	result, height, err := h.queryMilestone(ctx, checkpointTypes.QueryMilestoneList, queryParams)
 	if err != nil {
-		logger.Error("Error while fetching milestone last no ack")
+		logger.Error("Error while fetching milestone list", "page", in.Page, "limit", in.Limit, "error", err)
 		return nil, err
 	}
 
-	resp := &proto.FetchLastNoAckMilestoneResponse{}
-	resp.Height = fmt.Sprint(result.Height)
+	var records []checkpointTypes.MilestoneRecord
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &records); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
-		logger.Error("Error unmarshalling milestone", "error", err)
-		return nil, err
+	resp := &pb.FetchMilestoneListResponse{}
+	resp.Height = fmt.Sprint(height)
+
+	for _, record := range records {
+		resp.Result = append(resp.Result, parseMilestoneRecord(record))
 	}
 
 	return resp, nil
 }
 
-func (h *HeimdallGRPCServer) FetchNoAckMilestone(ctx context.Context, in *proto.FetchMilestoneNoAckRequest) (*proto.FetchMilestoneNoAckResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+func (h *HeimdallGRPCServer) FetchMilestoneByID(ctx context.Context, in *pb.FetchMilestoneByIDRequest) (*pb.FetchMilestoneRecordResponse, error) {
+	queryParams, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryMilestoneID(in.MilestoneID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	url := fmt.Sprintf(fetchMilestoneNoAck, fmt.Sprint(in.MilestoneID))
+	return h.fetchMilestoneRecord(ctx, checkpointTypes.QueryMilestoneByID, queryParams)
+}
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(url))
+func (h *HeimdallGRPCServer) FetchMilestoneByBorBlock(ctx context.Context, in *pb.FetchMilestoneByBorBlockRequest) (*pb.FetchMilestoneRecordResponse, error) {
+	queryParams, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryBorBlockParams(in.BlockNumber))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
+	return h.fetchMilestoneRecord(ctx, checkpointTypes.QueryMilestoneByBorBlock, queryParams)
+}
+
+func (h *HeimdallGRPCServer) FetchMilestoneStats(ctx context.Context, in *pb.FetchMilestoneStatsRequest) (*pb.FetchMilestoneStatsResponse, error) {
+	var proposer hmTypes.HeimdallAddress
+	if in.Proposer != nil {
+		address := protoutils.ConvertH160toAddress(in.Proposer)
+		proposer = hmTypes.BytesToHeimdallAddress(address[:])
+	}
+
+	queryParams, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryMilestoneStatsParams(proposer))
 	if err != nil {
-		logger.Error("Error while fetching milestone no ack")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, height, err := h.queryMilestone(ctx, checkpointTypes.QueryMilestoneStats, queryParams)
+	if err != nil {
+		logger.Error("Error while fetching milestone stats", "proposer", proposer, "error", err)
 		return nil, err
 	}
 
-	resp := &proto.FetchMilestoneNoAckResponse{}
-	resp.Height = fmt.Sprint(result.Height)
+	var allStats []checkpointTypes.MilestoneStats
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &allStats); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
-		logger.Error("Error unmarshalling milestone", "error", err)
+	resp := &pb.FetchMilestoneStatsResponse{}
+	resp.Height = fmt.Sprint(height)
+
+	for _, stats := range allStats {
+		var address [20]byte
+
+		copy(address[:], stats.Proposer.Bytes())
+
+		resp.Result = append(resp.Result, &pb.MilestoneStats{
+			Proposer:    protoutils.ConvertAddressToH160(address),
+			Proposed:    stats.Proposed,
+			Accepted:    stats.Accepted,
+			TimedOut:    stats.TimedOut,
+			NoAcked:     stats.NoAcked,
+			TotalLength: stats.TotalLength,
+			AvgLength:   stats.AvgLength,
+		})
+	}
+
+	return resp, nil
+}
+
+// fetchMilestoneRecord returns the milestone of the history found by the query
+func (h *HeimdallGRPCServer) fetchMilestoneRecord(ctx context.Context, path string, data []byte) (*pb.FetchMilestoneRecordResponse, error) {
+	result, height, err := h.queryMilestone(ctx, path, data)
+	if err != nil {
+		logger.Error("Error while fetching milestone", "path", path, "error", err)
 		return nil, err
 	}
 
+	var record checkpointTypes.MilestoneRecord
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &record); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.FetchMilestoneRecordResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = parseMilestoneRecord(record)
+
 	return resp, nil
 }
 
-func (h *HeimdallGRPCServer) FetchMilestoneID(ctx context.Context, in *proto.FetchMilestoneIDRequest) (*proto.FetchMilestoneIDResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+// fetchMilestoneCount returns the number of milestones added so far
+func (h *HeimdallGRPCServer) fetchMilestoneCount(ctx context.Context) (uint64, int64, error) {
+	result, height, err := h.queryMilestone(ctx, checkpointTypes.QueryCount, nil)
+	if err != nil {
+		return 0, height, err
+	}
 
-	url := fmt.Sprintf(fetchMilestoneID, fmt.Sprint(in.MilestoneID))
+	var count uint64
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &count); err != nil {
+		return 0, height, status.Error(codes.Internal, err.Error())
+	}
+
+	return count, height, nil
+}
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(url))
+// fetchMilestoneByNumber returns the milestone stored under the given number
+func (h *HeimdallGRPCServer) fetchMilestoneByNumber(ctx context.Context, number uint64) (hmTypes.Milestone, int64, error) {
+	var milestone hmTypes.Milestone
 
+	queryParams, err := h.cdc.MarshalJSON(checkpointTypes.NewQueryMilestoneParams(number))
 	if err != nil {
-		logger.Error("Error while fetching milestone id")
-		return nil, err
+		return milestone, 0, status.Error(codes.Internal, err.Error())
 	}
 
-	resp := &proto.FetchMilestoneIDResponse{}
-	resp.Height = fmt.Sprint(result.Height)
+	result, height, err := h.queryMilestone(ctx, checkpointTypes.QueryMilestoneByNumber, queryParams)
+	if err != nil {
+		return milestone, height, err
+	}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
-		logger.Error("Error unmarshalling milestone", "error", err)
-		return nil, err
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &milestone); err != nil {
+		return milestone, height, status.Error(codes.Internal, err.Error())
 	}
 
-	return resp, nil
+	return milestone, height, nil
+}
+
+// queryMilestone runs a milestone query. Milestones are only found once the
+// Aalborg hardfork is active, before it their absence is reported as such.
+func (h *HeimdallGRPCServer) queryMilestone(ctx context.Context, path string, data []byte) ([]byte, int64, error) {
+	result, height, err := h.query(ctx, checkpointTypes.QuerierRoute, path, data)
+	if err == nil {
+		return result, height, nil
+	}
+
+	// the height is unknown when the query didn't reach the application
+	if status.Code(err) == codes.NotFound && height > 0 && height < helper.GetAalborgHardForkHeight() {
+		return nil, height, status.Error(codes.Unavailable, "Aalborg hardfork not activated yet")
+	}
+
+	return nil, height, err
+}
+
+func parseMilestone(milestone hmTypes.Milestone) *proto.Milestone {
+	var hash [32]byte
+
+	copy(hash[:], milestone.Hash.Bytes())
+
+	var address [20]byte
+
+	copy(address[:], milestone.Proposer.Bytes())
+
+	return &proto.Milestone{
+		StartBlock: milestone.StartBlock,
+		EndBlock:   milestone.EndBlock,
+		RootHash:   protoutils.ConvertHashToH256(hash),
+		Proposer:   protoutils.ConvertAddressToH160(address),
+		Timestamp:  timestamppb.New(time.Unix(int64(milestone.TimeStamp), 0)),
+		BorChainID: milestone.BorChainID,
+	}
+}
+
+func parseMilestoneRecord(record checkpointTypes.MilestoneRecord) *pb.MilestoneRecord {
+	return &pb.MilestoneRecord{
+		Number:      record.Number,
+		MilestoneID: record.Milestone.MilestoneID,
+		Milestone:   parseMilestone(record.Milestone),
+	}
 }
@@ -0,0 +1,1485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: server/gRPC/pb/checkpoint.proto
+
+package pb
+
+import (
+	heimdall "github.com/maticnetwork/polyproto/heimdall"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExitProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckpointNumber  uint64         `protobuf:"varint,1,opt,name=CheckpointNumber,proto3" json:"CheckpointNumber,omitempty"`
+	HeaderBlockNumber uint64         `protobuf:"varint,2,opt,name=HeaderBlockNumber,proto3" json:"HeaderBlockNumber,omitempty"`
+	StartBlock        uint64         `protobuf:"varint,3,opt,name=StartBlock,proto3" json:"StartBlock,omitempty"`
+	EndBlock          uint64         `protobuf:"varint,4,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
+	RootHash          *heimdall.H256 `protobuf:"bytes,5,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	BlockNumber       uint64         `protobuf:"varint,6,opt,name=BlockNumber,proto3" json:"BlockNumber,omitempty"`
+	BlockTime         uint64         `protobuf:"varint,7,opt,name=BlockTime,proto3" json:"BlockTime,omitempty"`
+	TxRoot            *heimdall.H256 `protobuf:"bytes,8,opt,name=TxRoot,proto3" json:"TxRoot,omitempty"`
+	ReceiptRoot       *heimdall.H256 `protobuf:"bytes,9,opt,name=ReceiptRoot,proto3" json:"ReceiptRoot,omitempty"`
+	BlockProof        []byte         `protobuf:"bytes,10,opt,name=BlockProof,proto3" json:"BlockProof,omitempty"`
+	// set for the proof of a tx log only
+	TxHash       *heimdall.H256 `protobuf:"bytes,11,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Receipt      []byte         `protobuf:"bytes,12,opt,name=Receipt,proto3" json:"Receipt,omitempty"`
+	ReceiptProof []byte         `protobuf:"bytes,13,opt,name=ReceiptProof,proto3" json:"ReceiptProof,omitempty"`
+	ReceiptPath  []byte         `protobuf:"bytes,14,opt,name=ReceiptPath,proto3" json:"ReceiptPath,omitempty"`
+	LogIndex     uint64         `protobuf:"varint,15,opt,name=LogIndex,proto3" json:"LogIndex,omitempty"`
+	// rlp encoded exit payload, ready to be submitted to the root chain
+	Payload []byte `protobuf:"bytes,16,opt,name=Payload,proto3" json:"Payload,omitempty"`
+}
+
+func (x *ExitProof) Reset() {
+	*x = ExitProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitProof) ProtoMessage() {}
+
+func (x *ExitProof) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitProof.ProtoReflect.Descriptor instead.
+func (*ExitProof) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExitProof) GetCheckpointNumber() uint64 {
+	if x != nil {
+		return x.CheckpointNumber
+	}
+	return 0
+}
+
+func (x *ExitProof) GetHeaderBlockNumber() uint64 {
+	if x != nil {
+		return x.HeaderBlockNumber
+	}
+	return 0
+}
+
+func (x *ExitProof) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *ExitProof) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *ExitProof) GetRootHash() *heimdall.H256 {
+	if x != nil {
+		return x.RootHash
+	}
+	return nil
+}
+
+func (x *ExitProof) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ExitProof) GetBlockTime() uint64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *ExitProof) GetTxRoot() *heimdall.H256 {
+	if x != nil {
+		return x.TxRoot
+	}
+	return nil
+}
+
+func (x *ExitProof) GetReceiptRoot() *heimdall.H256 {
+	if x != nil {
+		return x.ReceiptRoot
+	}
+	return nil
+}
+
+func (x *ExitProof) GetBlockProof() []byte {
+	if x != nil {
+		return x.BlockProof
+	}
+	return nil
+}
+
+func (x *ExitProof) GetTxHash() *heimdall.H256 {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *ExitProof) GetReceipt() []byte {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *ExitProof) GetReceiptProof() []byte {
+	if x != nil {
+		return x.ReceiptProof
+	}
+	return nil
+}
+
+func (x *ExitProof) GetReceiptPath() []byte {
+	if x != nil {
+		return x.ReceiptPath
+	}
+	return nil
+}
+
+func (x *ExitProof) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *ExitProof) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type FetchExitProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bor block number, ignored when a tx hash is given
+	BlockNumber uint64         `protobuf:"varint,1,opt,name=BlockNumber,proto3" json:"BlockNumber,omitempty"`
+	TxHash      *heimdall.H256 `protobuf:"bytes,2,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	// index of the log in the tx receipt
+	LogIndex uint64 `protobuf:"varint,3,opt,name=LogIndex,proto3" json:"LogIndex,omitempty"`
+}
+
+func (x *FetchExitProofRequest) Reset() {
+	*x = FetchExitProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchExitProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchExitProofRequest) ProtoMessage() {}
+
+func (x *FetchExitProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchExitProofRequest.ProtoReflect.Descriptor instead.
+func (*FetchExitProofRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FetchExitProofRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *FetchExitProofRequest) GetTxHash() *heimdall.H256 {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *FetchExitProofRequest) GetLogIndex() uint64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+type FetchExitProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string     `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result *ExitProof `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchExitProofResponse) Reset() {
+	*x = FetchExitProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchExitProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchExitProofResponse) ProtoMessage() {}
+
+func (x *FetchExitProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchExitProofResponse.ProtoReflect.Descriptor instead.
+func (*FetchExitProofResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FetchExitProofResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchExitProofResponse) GetResult() *ExitProof {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type BorBlockStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber  uint64 `protobuf:"varint,1,opt,name=BlockNumber,proto3" json:"BlockNumber,omitempty"`
+	Checkpointed bool   `protobuf:"varint,2,opt,name=Checkpointed,proto3" json:"Checkpointed,omitempty"`
+	// set when the block is checkpointed only
+	CheckpointNumber uint64               `protobuf:"varint,3,opt,name=CheckpointNumber,proto3" json:"CheckpointNumber,omitempty"`
+	Checkpoint       *heimdall.Checkpoint `protobuf:"bytes,4,opt,name=Checkpoint,proto3" json:"Checkpoint,omitempty"`
+	MilestoneCovered bool                 `protobuf:"varint,5,opt,name=MilestoneCovered,proto3" json:"MilestoneCovered,omitempty"`
+}
+
+func (x *BorBlockStatus) Reset() {
+	*x = BorBlockStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BorBlockStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BorBlockStatus) ProtoMessage() {}
+
+func (x *BorBlockStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BorBlockStatus.ProtoReflect.Descriptor instead.
+func (*BorBlockStatus) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BorBlockStatus) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *BorBlockStatus) GetCheckpointed() bool {
+	if x != nil {
+		return x.Checkpointed
+	}
+	return false
+}
+
+func (x *BorBlockStatus) GetCheckpointNumber() uint64 {
+	if x != nil {
+		return x.CheckpointNumber
+	}
+	return 0
+}
+
+func (x *BorBlockStatus) GetCheckpoint() *heimdall.Checkpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *BorBlockStatus) GetMilestoneCovered() bool {
+	if x != nil {
+		return x.MilestoneCovered
+	}
+	return false
+}
+
+type FetchBorBlockStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=BlockNumber,proto3" json:"BlockNumber,omitempty"`
+}
+
+func (x *FetchBorBlockStatusRequest) Reset() {
+	*x = FetchBorBlockStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchBorBlockStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchBorBlockStatusRequest) ProtoMessage() {}
+
+func (x *FetchBorBlockStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchBorBlockStatusRequest.ProtoReflect.Descriptor instead.
+func (*FetchBorBlockStatusRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FetchBorBlockStatusRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type FetchBorBlockStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string          `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result *BorBlockStatus `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchBorBlockStatusResponse) Reset() {
+	*x = FetchBorBlockStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchBorBlockStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchBorBlockStatusResponse) ProtoMessage() {}
+
+func (x *FetchBorBlockStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchBorBlockStatusResponse.ProtoReflect.Descriptor instead.
+func (*FetchBorBlockStatusResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FetchBorBlockStatusResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchBorBlockStatusResponse) GetResult() *BorBlockStatus {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type MilestoneRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number      uint64              `protobuf:"varint,1,opt,name=Number,proto3" json:"Number,omitempty"`
+	MilestoneID string              `protobuf:"bytes,2,opt,name=MilestoneID,proto3" json:"MilestoneID,omitempty"`
+	Milestone   *heimdall.Milestone `protobuf:"bytes,3,opt,name=Milestone,proto3" json:"Milestone,omitempty"`
+}
+
+func (x *MilestoneRecord) Reset() {
+	*x = MilestoneRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MilestoneRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MilestoneRecord) ProtoMessage() {}
+
+func (x *MilestoneRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MilestoneRecord.ProtoReflect.Descriptor instead.
+func (*MilestoneRecord) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MilestoneRecord) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MilestoneRecord) GetMilestoneID() string {
+	if x != nil {
+		return x.MilestoneID
+	}
+	return ""
+}
+
+func (x *MilestoneRecord) GetMilestone() *heimdall.Milestone {
+	if x != nil {
+		return x.Milestone
+	}
+	return nil
+}
+
+type FetchMilestoneListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page  uint64 `protobuf:"varint,1,opt,name=Page,proto3" json:"Page,omitempty"`
+	Limit uint64 `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *FetchMilestoneListRequest) Reset() {
+	*x = FetchMilestoneListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMilestoneListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMilestoneListRequest) ProtoMessage() {}
+
+func (x *FetchMilestoneListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMilestoneListRequest.ProtoReflect.Descriptor instead.
+func (*FetchMilestoneListRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FetchMilestoneListRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *FetchMilestoneListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FetchMilestoneListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string             `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result []*MilestoneRecord `protobuf:"bytes,2,rep,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchMilestoneListResponse) Reset() {
+	*x = FetchMilestoneListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMilestoneListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMilestoneListResponse) ProtoMessage() {}
+
+func (x *FetchMilestoneListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMilestoneListResponse.ProtoReflect.Descriptor instead.
+func (*FetchMilestoneListResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FetchMilestoneListResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchMilestoneListResponse) GetResult() []*MilestoneRecord {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type FetchMilestoneByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MilestoneID string `protobuf:"bytes,1,opt,name=MilestoneID,proto3" json:"MilestoneID,omitempty"`
+}
+
+func (x *FetchMilestoneByIDRequest) Reset() {
+	*x = FetchMilestoneByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMilestoneByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMilestoneByIDRequest) ProtoMessage() {}
+
+func (x *FetchMilestoneByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMilestoneByIDRequest.ProtoReflect.Descriptor instead.
+func (*FetchMilestoneByIDRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FetchMilestoneByIDRequest) GetMilestoneID() string {
+	if x != nil {
+		return x.MilestoneID
+	}
+	return ""
+}
+
+type FetchMilestoneByBorBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=BlockNumber,proto3" json:"BlockNumber,omitempty"`
+}
+
+func (x *FetchMilestoneByBorBlockRequest) Reset() {
+	*x = FetchMilestoneByBorBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMilestoneByBorBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMilestoneByBorBlockRequest) ProtoMessage() {}
+
+func (x *FetchMilestoneByBorBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMilestoneByBorBlockRequest.ProtoReflect.Descriptor instead.
+func (*FetchMilestoneByBorBlockRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FetchMilestoneByBorBlockRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type FetchMilestoneRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string           `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result *MilestoneRecord `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchMilestoneRecordResponse) Reset() {
+	*x = FetchMilestoneRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMilestoneRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMilestoneRecordResponse) ProtoMessage() {}
+
+func (x *FetchMilestoneRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMilestoneRecordResponse.ProtoReflect.Descriptor instead.
+func (*FetchMilestoneRecordResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FetchMilestoneRecordResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchMilestoneRecordResponse) GetResult() *MilestoneRecord {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type MilestoneStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proposer *heimdall.H160 `protobuf:"bytes,1,opt,name=Proposer,proto3" json:"Proposer,omitempty"`
+	Proposed uint64         `protobuf:"varint,2,opt,name=Proposed,proto3" json:"Proposed,omitempty"`
+	Accepted uint64         `protobuf:"varint,3,opt,name=Accepted,proto3" json:"Accepted,omitempty"`
+	TimedOut uint64         `protobuf:"varint,4,opt,name=TimedOut,proto3" json:"TimedOut,omitempty"`
+	NoAcked  uint64         `protobuf:"varint,5,opt,name=NoAcked,proto3" json:"NoAcked,omitempty"`
+	// bor blocks of the accepted milestones
+	TotalLength uint64 `protobuf:"varint,6,opt,name=TotalLength,proto3" json:"TotalLength,omitempty"`
+	AvgLength   uint64 `protobuf:"varint,7,opt,name=AvgLength,proto3" json:"AvgLength,omitempty"`
+}
+
+func (x *MilestoneStats) Reset() {
+	*x = MilestoneStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MilestoneStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MilestoneStats) ProtoMessage() {}
+
+func (x *MilestoneStats) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MilestoneStats.ProtoReflect.Descriptor instead.
+func (*MilestoneStats) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MilestoneStats) GetProposer() *heimdall.H160 {
+	if x != nil {
+		return x.Proposer
+	}
+	return nil
+}
+
+func (x *MilestoneStats) GetProposed() uint64 {
+	if x != nil {
+		return x.Proposed
+	}
+	return 0
+}
+
+func (x *MilestoneStats) GetAccepted() uint64 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *MilestoneStats) GetTimedOut() uint64 {
+	if x != nil {
+		return x.TimedOut
+	}
+	return 0
+}
+
+func (x *MilestoneStats) GetNoAcked() uint64 {
+	if x != nil {
+		return x.NoAcked
+	}
+	return 0
+}
+
+func (x *MilestoneStats) GetTotalLength() uint64 {
+	if x != nil {
+		return x.TotalLength
+	}
+	return 0
+}
+
+func (x *MilestoneStats) GetAvgLength() uint64 {
+	if x != nil {
+		return x.AvgLength
+	}
+	return 0
+}
+
+type FetchMilestoneStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stats of all the proposers when not set
+	Proposer *heimdall.H160 `protobuf:"bytes,1,opt,name=Proposer,proto3" json:"Proposer,omitempty"`
+}
+
+func (x *FetchMilestoneStatsRequest) Reset() {
+	*x = FetchMilestoneStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMilestoneStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMilestoneStatsRequest) ProtoMessage() {}
+
+func (x *FetchMilestoneStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMilestoneStatsRequest.ProtoReflect.Descriptor instead.
+func (*FetchMilestoneStatsRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FetchMilestoneStatsRequest) GetProposer() *heimdall.H160 {
+	if x != nil {
+		return x.Proposer
+	}
+	return nil
+}
+
+type FetchMilestoneStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string            `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result []*MilestoneStats `protobuf:"bytes,2,rep,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchMilestoneStatsResponse) Reset() {
+	*x = FetchMilestoneStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMilestoneStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMilestoneStatsResponse) ProtoMessage() {}
+
+func (x *FetchMilestoneStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_checkpoint_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMilestoneStatsResponse.ProtoReflect.Descriptor instead.
+func (*FetchMilestoneStatsResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_checkpoint_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FetchMilestoneStatsResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchMilestoneStatsResponse) GetResult() []*MilestoneStats {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_server_gRPC_pb_checkpoint_proto protoreflect.FileDescriptor
+
+var file_server_gRPC_pb_checkpoint_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x52, 0x50, 0x43, 0x2f, 0x70, 0x62,
+	0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x1a, 0x17, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2f, 0x68, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x04, 0x0a, 0x09,
+	0x45, 0x78, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x2a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x32, 0x35,
+	0x36, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x54,
+	0x78, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x32, 0x35, 0x36, 0x52, 0x06, 0x54, 0x78, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x6f,
+	0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x32, 0x35, 0x36, 0x52, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x2e, 0x48, 0x32, 0x35, 0x36, 0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0x7d, 0x0a, 0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x78, 0x69, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x32, 0x35, 0x36, 0x52, 0x06,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x64, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x78, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x0e, 0x42, 0x6f, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65,
+	0x64, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a,
+	0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x22,
+	0x3e, 0x0a, 0x1a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0x6e, 0x0a, 0x1b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x7e, 0x0a, 0x0f, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09,
+	0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x4d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x52, 0x09, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x22,
+	0x45, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6e, 0x0a, 0x1a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3d, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x1f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x42, 0x79, 0x42, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x1c, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xea, 0x01, 0x0a,
+	0x0e, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x2a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x31, 0x36,
+	0x30, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x4e, 0x6f, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x4e, 0x6f, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x41,
+	0x76, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x41, 0x76, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x48, 0x0a, 0x1a, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x31, 0x36, 0x30, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x1b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x32, 0xc4, 0x05, 0x0a, 0x12, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x0e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x45, 0x78, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x45, 0x78, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x78, 0x69, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x72, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6f,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x72, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x69, 0x6d,
+	0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x42, 0x79, 0x42, 0x6f, 0x72, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x30, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x42, 0x79, 0x42, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c,
+	0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2b, 0x2e,
+	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x69,
+	0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x52, 0x50, 0x43, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_server_gRPC_pb_checkpoint_proto_rawDescOnce sync.Once
+	file_server_gRPC_pb_checkpoint_proto_rawDescData = file_server_gRPC_pb_checkpoint_proto_rawDesc
+)
+
+func file_server_gRPC_pb_checkpoint_proto_rawDescGZIP() []byte {
+	file_server_gRPC_pb_checkpoint_proto_rawDescOnce.Do(func() {
+		file_server_gRPC_pb_checkpoint_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_gRPC_pb_checkpoint_proto_rawDescData)
+	})
+	return file_server_gRPC_pb_checkpoint_proto_rawDescData
+}
+
+var file_server_gRPC_pb_checkpoint_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_server_gRPC_pb_checkpoint_proto_goTypes = []interface{}{
+	(*ExitProof)(nil),                       // 0: heimdall.server.ExitProof
+	(*FetchExitProofRequest)(nil),           // 1: heimdall.server.FetchExitProofRequest
+	(*FetchExitProofResponse)(nil),          // 2: heimdall.server.FetchExitProofResponse
+	(*BorBlockStatus)(nil),                  // 3: heimdall.server.BorBlockStatus
+	(*FetchBorBlockStatusRequest)(nil),      // 4: heimdall.server.FetchBorBlockStatusRequest
+	(*FetchBorBlockStatusResponse)(nil),     // 5: heimdall.server.FetchBorBlockStatusResponse
+	(*MilestoneRecord)(nil),                 // 6: heimdall.server.MilestoneRecord
+	(*FetchMilestoneListRequest)(nil),       // 7: heimdall.server.FetchMilestoneListRequest
+	(*FetchMilestoneListResponse)(nil),      // 8: heimdall.server.FetchMilestoneListResponse
+	(*FetchMilestoneByIDRequest)(nil),       // 9: heimdall.server.FetchMilestoneByIDRequest
+	(*FetchMilestoneByBorBlockRequest)(nil), // 10: heimdall.server.FetchMilestoneByBorBlockRequest
+	(*FetchMilestoneRecordResponse)(nil),    // 11: heimdall.server.FetchMilestoneRecordResponse
+	(*MilestoneStats)(nil),                  // 12: heimdall.server.MilestoneStats
+	(*FetchMilestoneStatsRequest)(nil),      // 13: heimdall.server.FetchMilestoneStatsRequest
+	(*FetchMilestoneStatsResponse)(nil),     // 14: heimdall.server.FetchMilestoneStatsResponse
+	(*heimdall.H256)(nil),                   // 15: heimdall.H256
+	(*heimdall.Checkpoint)(nil),             // 16: heimdall.Checkpoint
+	(*heimdall.Milestone)(nil),              // 17: heimdall.Milestone
+	(*heimdall.H160)(nil),                   // 18: heimdall.H160
+}
+var file_server_gRPC_pb_checkpoint_proto_depIdxs = []int32{
+	15, // 0: heimdall.server.ExitProof.RootHash:type_name -> heimdall.H256
+	15, // 1: heimdall.server.ExitProof.TxRoot:type_name -> heimdall.H256
+	15, // 2: heimdall.server.ExitProof.ReceiptRoot:type_name -> heimdall.H256
+	15, // 3: heimdall.server.ExitProof.TxHash:type_name -> heimdall.H256
+	15, // 4: heimdall.server.FetchExitProofRequest.TxHash:type_name -> heimdall.H256
+	0,  // 5: heimdall.server.FetchExitProofResponse.Result:type_name -> heimdall.server.ExitProof
+	16, // 6: heimdall.server.BorBlockStatus.Checkpoint:type_name -> heimdall.Checkpoint
+	3,  // 7: heimdall.server.FetchBorBlockStatusResponse.Result:type_name -> heimdall.server.BorBlockStatus
+	17, // 8: heimdall.server.MilestoneRecord.Milestone:type_name -> heimdall.Milestone
+	6,  // 9: heimdall.server.FetchMilestoneListResponse.Result:type_name -> heimdall.server.MilestoneRecord
+	6,  // 10: heimdall.server.FetchMilestoneRecordResponse.Result:type_name -> heimdall.server.MilestoneRecord
+	18, // 11: heimdall.server.MilestoneStats.Proposer:type_name -> heimdall.H160
+	18, // 12: heimdall.server.FetchMilestoneStatsRequest.Proposer:type_name -> heimdall.H160
+	12, // 13: heimdall.server.FetchMilestoneStatsResponse.Result:type_name -> heimdall.server.MilestoneStats
+	1,  // 14: heimdall.server.HeimdallCheckpoint.FetchExitProof:input_type -> heimdall.server.FetchExitProofRequest
+	4,  // 15: heimdall.server.HeimdallCheckpoint.FetchBorBlockStatus:input_type -> heimdall.server.FetchBorBlockStatusRequest
+	7,  // 16: heimdall.server.HeimdallCheckpoint.FetchMilestoneList:input_type -> heimdall.server.FetchMilestoneListRequest
+	9,  // 17: heimdall.server.HeimdallCheckpoint.FetchMilestoneByID:input_type -> heimdall.server.FetchMilestoneByIDRequest
+	10, // 18: heimdall.server.HeimdallCheckpoint.FetchMilestoneByBorBlock:input_type -> heimdall.server.FetchMilestoneByBorBlockRequest
+	13, // 19: heimdall.server.HeimdallCheckpoint.FetchMilestoneStats:input_type -> heimdall.server.FetchMilestoneStatsRequest
+	2,  // 20: heimdall.server.HeimdallCheckpoint.FetchExitProof:output_type -> heimdall.server.FetchExitProofResponse
+	5,  // 21: heimdall.server.HeimdallCheckpoint.FetchBorBlockStatus:output_type -> heimdall.server.FetchBorBlockStatusResponse
+	8,  // 22: heimdall.server.HeimdallCheckpoint.FetchMilestoneList:output_type -> heimdall.server.FetchMilestoneListResponse
+	11, // 23: heimdall.server.HeimdallCheckpoint.FetchMilestoneByID:output_type -> heimdall.server.FetchMilestoneRecordResponse
+	11, // 24: heimdall.server.HeimdallCheckpoint.FetchMilestoneByBorBlock:output_type -> heimdall.server.FetchMilestoneRecordResponse
+	14, // 25: heimdall.server.HeimdallCheckpoint.FetchMilestoneStats:output_type -> heimdall.server.FetchMilestoneStatsResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_server_gRPC_pb_checkpoint_proto_init() }
+func file_server_gRPC_pb_checkpoint_proto_init() {
+	if File_server_gRPC_pb_checkpoint_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchExitProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchExitProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BorBlockStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchBorBlockStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchBorBlockStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MilestoneRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMilestoneListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMilestoneListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMilestoneByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMilestoneByBorBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMilestoneRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MilestoneStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMilestoneStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_checkpoint_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMilestoneStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_server_gRPC_pb_checkpoint_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_server_gRPC_pb_checkpoint_proto_goTypes,
+		DependencyIndexes: file_server_gRPC_pb_checkpoint_proto_depIdxs,
+		MessageInfos:      file_server_gRPC_pb_checkpoint_proto_msgTypes,
+	}.Build()
+	File_server_gRPC_pb_checkpoint_proto = out.File
+	file_server_gRPC_pb_checkpoint_proto_rawDesc = nil
+	file_server_gRPC_pb_checkpoint_proto_goTypes = nil
+	file_server_gRPC_pb_checkpoint_proto_depIdxs = nil
+}
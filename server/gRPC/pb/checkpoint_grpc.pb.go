@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: server/gRPC/pb/checkpoint.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// HeimdallCheckpointClient is the client API for HeimdallCheckpoint service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HeimdallCheckpointClient interface {
+	FetchExitProof(ctx context.Context, in *FetchExitProofRequest, opts ...grpc.CallOption) (*FetchExitProofResponse, error)
+	FetchBorBlockStatus(ctx context.Context, in *FetchBorBlockStatusRequest, opts ...grpc.CallOption) (*FetchBorBlockStatusResponse, error)
+	FetchMilestoneList(ctx context.Context, in *FetchMilestoneListRequest, opts ...grpc.CallOption) (*FetchMilestoneListResponse, error)
+	FetchMilestoneByID(ctx context.Context, in *FetchMilestoneByIDRequest, opts ...grpc.CallOption) (*FetchMilestoneRecordResponse, error)
+	FetchMilestoneByBorBlock(ctx context.Context, in *FetchMilestoneByBorBlockRequest, opts ...grpc.CallOption) (*FetchMilestoneRecordResponse, error)
+	FetchMilestoneStats(ctx context.Context, in *FetchMilestoneStatsRequest, opts ...grpc.CallOption) (*FetchMilestoneStatsResponse, error)
+}
+
+type heimdallCheckpointClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeimdallCheckpointClient(cc grpc.ClientConnInterface) HeimdallCheckpointClient {
+	return &heimdallCheckpointClient{cc}
+}
+
+func (c *heimdallCheckpointClient) FetchExitProof(ctx context.Context, in *FetchExitProofRequest, opts ...grpc.CallOption) (*FetchExitProofResponse, error) {
+	out := new(FetchExitProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallCheckpoint/FetchExitProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallCheckpointClient) FetchBorBlockStatus(ctx context.Context, in *FetchBorBlockStatusRequest, opts ...grpc.CallOption) (*FetchBorBlockStatusResponse, error) {
+	out := new(FetchBorBlockStatusResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallCheckpoint/FetchBorBlockStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallCheckpointClient) FetchMilestoneList(ctx context.Context, in *FetchMilestoneListRequest, opts ...grpc.CallOption) (*FetchMilestoneListResponse, error) {
+	out := new(FetchMilestoneListResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallCheckpoint/FetchMilestoneList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallCheckpointClient) FetchMilestoneByID(ctx context.Context, in *FetchMilestoneByIDRequest, opts ...grpc.CallOption) (*FetchMilestoneRecordResponse, error) {
+	out := new(FetchMilestoneRecordResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallCheckpoint/FetchMilestoneByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallCheckpointClient) FetchMilestoneByBorBlock(ctx context.Context, in *FetchMilestoneByBorBlockRequest, opts ...grpc.CallOption) (*FetchMilestoneRecordResponse, error) {
+	out := new(FetchMilestoneRecordResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallCheckpoint/FetchMilestoneByBorBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallCheckpointClient) FetchMilestoneStats(ctx context.Context, in *FetchMilestoneStatsRequest, opts ...grpc.CallOption) (*FetchMilestoneStatsResponse, error) {
+	out := new(FetchMilestoneStatsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallCheckpoint/FetchMilestoneStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HeimdallCheckpointServer is the server API for HeimdallCheckpoint service.
+// All implementations must embed UnimplementedHeimdallCheckpointServer
+// for forward compatibility
+type HeimdallCheckpointServer interface {
+	FetchExitProof(context.Context, *FetchExitProofRequest) (*FetchExitProofResponse, error)
+	FetchBorBlockStatus(context.Context, *FetchBorBlockStatusRequest) (*FetchBorBlockStatusResponse, error)
+	FetchMilestoneList(context.Context, *FetchMilestoneListRequest) (*FetchMilestoneListResponse, error)
+	FetchMilestoneByID(context.Context, *FetchMilestoneByIDRequest) (*FetchMilestoneRecordResponse, error)
+	FetchMilestoneByBorBlock(context.Context, *FetchMilestoneByBorBlockRequest) (*FetchMilestoneRecordResponse, error)
+	FetchMilestoneStats(context.Context, *FetchMilestoneStatsRequest) (*FetchMilestoneStatsResponse, error)
+	mustEmbedUnimplementedHeimdallCheckpointServer()
+}
+
+// UnimplementedHeimdallCheckpointServer must be embedded to have forward compatible implementations.
+type UnimplementedHeimdallCheckpointServer struct {
+}
+
+func (UnimplementedHeimdallCheckpointServer) FetchExitProof(context.Context, *FetchExitProofRequest) (*FetchExitProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchExitProof not implemented")
+}
+func (UnimplementedHeimdallCheckpointServer) FetchBorBlockStatus(context.Context, *FetchBorBlockStatusRequest) (*FetchBorBlockStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBorBlockStatus not implemented")
+}
+func (UnimplementedHeimdallCheckpointServer) FetchMilestoneList(context.Context, *FetchMilestoneListRequest) (*FetchMilestoneListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMilestoneList not implemented")
+}
+func (UnimplementedHeimdallCheckpointServer) FetchMilestoneByID(context.Context, *FetchMilestoneByIDRequest) (*FetchMilestoneRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMilestoneByID not implemented")
+}
+func (UnimplementedHeimdallCheckpointServer) FetchMilestoneByBorBlock(context.Context, *FetchMilestoneByBorBlockRequest) (*FetchMilestoneRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMilestoneByBorBlock not implemented")
+}
+func (UnimplementedHeimdallCheckpointServer) FetchMilestoneStats(context.Context, *FetchMilestoneStatsRequest) (*FetchMilestoneStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMilestoneStats not implemented")
+}
+func (UnimplementedHeimdallCheckpointServer) mustEmbedUnimplementedHeimdallCheckpointServer() {}
+
+// UnsafeHeimdallCheckpointServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HeimdallCheckpointServer will
+// result in compilation errors.
+type UnsafeHeimdallCheckpointServer interface {
+	mustEmbedUnimplementedHeimdallCheckpointServer()
+}
+
+func RegisterHeimdallCheckpointServer(s grpc.ServiceRegistrar, srv HeimdallCheckpointServer) {
+	s.RegisterService(&HeimdallCheckpoint_ServiceDesc, srv)
+}
+
+func _HeimdallCheckpoint_FetchExitProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchExitProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallCheckpointServer).FetchExitProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallCheckpoint/FetchExitProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallCheckpointServer).FetchExitProof(ctx, req.(*FetchExitProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallCheckpoint_FetchBorBlockStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBorBlockStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallCheckpointServer).FetchBorBlockStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallCheckpoint/FetchBorBlockStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallCheckpointServer).FetchBorBlockStatus(ctx, req.(*FetchBorBlockStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallCheckpoint_FetchMilestoneList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchMilestoneListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallCheckpoint/FetchMilestoneList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneList(ctx, req.(*FetchMilestoneListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallCheckpoint_FetchMilestoneByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchMilestoneByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallCheckpoint/FetchMilestoneByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneByID(ctx, req.(*FetchMilestoneByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallCheckpoint_FetchMilestoneByBorBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchMilestoneByBorBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneByBorBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallCheckpoint/FetchMilestoneByBorBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneByBorBlock(ctx, req.(*FetchMilestoneByBorBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallCheckpoint_FetchMilestoneStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchMilestoneStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallCheckpoint/FetchMilestoneStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallCheckpointServer).FetchMilestoneStats(ctx, req.(*FetchMilestoneStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HeimdallCheckpoint_ServiceDesc is the grpc.ServiceDesc for HeimdallCheckpoint service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HeimdallCheckpoint_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.server.HeimdallCheckpoint",
+	HandlerType: (*HeimdallCheckpointServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchExitProof",
+			Handler:    _HeimdallCheckpoint_FetchExitProof_Handler,
+		},
+		{
+			MethodName: "FetchBorBlockStatus",
+			Handler:    _HeimdallCheckpoint_FetchBorBlockStatus_Handler,
+		},
+		{
+			MethodName: "FetchMilestoneList",
+			Handler:    _HeimdallCheckpoint_FetchMilestoneList_Handler,
+		},
+		{
+			MethodName: "FetchMilestoneByID",
+			Handler:    _HeimdallCheckpoint_FetchMilestoneByID_Handler,
+		},
+		{
+			MethodName: "FetchMilestoneByBorBlock",
+			Handler:    _HeimdallCheckpoint_FetchMilestoneByBorBlock_Handler,
+		},
+		{
+			MethodName: "FetchMilestoneStats",
+			Handler:    _HeimdallCheckpoint_FetchMilestoneStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/gRPC/pb/checkpoint.proto",
+}
@@ -0,0 +1,976 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: server/gRPC/pb/staking.proto
+
+package pb
+
+import (
+	heimdall "github.com/maticnetwork/polyproto/heimdall"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StakingValidator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID               uint64         `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	StartEpoch       uint64         `protobuf:"varint,2,opt,name=StartEpoch,proto3" json:"StartEpoch,omitempty"`
+	EndEpoch         uint64         `protobuf:"varint,3,opt,name=EndEpoch,proto3" json:"EndEpoch,omitempty"`
+	Nonce            uint64         `protobuf:"varint,4,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	VotingPower      int64          `protobuf:"varint,5,opt,name=VotingPower,proto3" json:"VotingPower,omitempty"`
+	PubKey           []byte         `protobuf:"bytes,6,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	Signer           *heimdall.H160 `protobuf:"bytes,7,opt,name=Signer,proto3" json:"Signer,omitempty"`
+	LastUpdated      string         `protobuf:"bytes,8,opt,name=LastUpdated,proto3" json:"LastUpdated,omitempty"`
+	Jailed           bool           `protobuf:"varint,9,opt,name=Jailed,proto3" json:"Jailed,omitempty"`
+	ProposerPriority int64          `protobuf:"varint,10,opt,name=ProposerPriority,proto3" json:"ProposerPriority,omitempty"`
+}
+
+func (x *StakingValidator) Reset() {
+	*x = StakingValidator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingValidator) ProtoMessage() {}
+
+func (x *StakingValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingValidator.ProtoReflect.Descriptor instead.
+func (*StakingValidator) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StakingValidator) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *StakingValidator) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *StakingValidator) GetEndEpoch() uint64 {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return 0
+}
+
+func (x *StakingValidator) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *StakingValidator) GetVotingPower() int64 {
+	if x != nil {
+		return x.VotingPower
+	}
+	return 0
+}
+
+func (x *StakingValidator) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *StakingValidator) GetSigner() *heimdall.H160 {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *StakingValidator) GetLastUpdated() string {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return ""
+}
+
+func (x *StakingValidator) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+func (x *StakingValidator) GetProposerPriority() int64 {
+	if x != nil {
+		return x.ProposerPriority
+	}
+	return 0
+}
+
+type StakingValidatorSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators       []*StakingValidator `protobuf:"bytes,1,rep,name=Validators,proto3" json:"Validators,omitempty"`
+	Proposer         *StakingValidator   `protobuf:"bytes,2,opt,name=Proposer,proto3" json:"Proposer,omitempty"`
+	TotalVotingPower int64               `protobuf:"varint,3,opt,name=TotalVotingPower,proto3" json:"TotalVotingPower,omitempty"`
+}
+
+func (x *StakingValidatorSet) Reset() {
+	*x = StakingValidatorSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingValidatorSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingValidatorSet) ProtoMessage() {}
+
+func (x *StakingValidatorSet) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingValidatorSet.ProtoReflect.Descriptor instead.
+func (*StakingValidatorSet) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StakingValidatorSet) GetValidators() []*StakingValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *StakingValidatorSet) GetProposer() *StakingValidator {
+	if x != nil {
+		return x.Proposer
+	}
+	return nil
+}
+
+func (x *StakingValidatorSet) GetTotalVotingPower() int64 {
+	if x != nil {
+		return x.TotalVotingPower
+	}
+	return 0
+}
+
+type SigningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValID               uint64 `protobuf:"varint,1,opt,name=ValID,proto3" json:"ValID,omitempty"`
+	StartHeight         int64  `protobuf:"varint,2,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	IndexOffset         int64  `protobuf:"varint,3,opt,name=IndexOffset,proto3" json:"IndexOffset,omitempty"`
+	MissedBlocksCounter int64  `protobuf:"varint,4,opt,name=MissedBlocksCounter,proto3" json:"MissedBlocksCounter,omitempty"`
+}
+
+func (x *SigningInfo) Reset() {
+	*x = SigningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningInfo) ProtoMessage() {}
+
+func (x *SigningInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningInfo.ProtoReflect.Descriptor instead.
+func (*SigningInfo) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SigningInfo) GetValID() uint64 {
+	if x != nil {
+		return x.ValID
+	}
+	return 0
+}
+
+func (x *SigningInfo) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *SigningInfo) GetIndexOffset() int64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *SigningInfo) GetMissedBlocksCounter() int64 {
+	if x != nil {
+		return x.MissedBlocksCounter
+	}
+	return 0
+}
+
+type FetchValidatorSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string               `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result *StakingValidatorSet `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchValidatorSetResponse) Reset() {
+	*x = FetchValidatorSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchValidatorSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchValidatorSetResponse) ProtoMessage() {}
+
+func (x *FetchValidatorSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchValidatorSetResponse.ProtoReflect.Descriptor instead.
+func (*FetchValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FetchValidatorSetResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchValidatorSetResponse) GetResult() *StakingValidatorSet {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type FetchValidatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator id, ignored when a signer is given
+	ID     uint64         `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Signer *heimdall.H160 `protobuf:"bytes,2,opt,name=Signer,proto3" json:"Signer,omitempty"`
+}
+
+func (x *FetchValidatorRequest) Reset() {
+	*x = FetchValidatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchValidatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchValidatorRequest) ProtoMessage() {}
+
+func (x *FetchValidatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchValidatorRequest.ProtoReflect.Descriptor instead.
+func (*FetchValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FetchValidatorRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *FetchValidatorRequest) GetSigner() *heimdall.H160 {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+type FetchValidatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string            `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result *StakingValidator `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchValidatorResponse) Reset() {
+	*x = FetchValidatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchValidatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchValidatorResponse) ProtoMessage() {}
+
+func (x *FetchValidatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchValidatorResponse.ProtoReflect.Descriptor instead.
+func (*FetchValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FetchValidatorResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchValidatorResponse) GetResult() *StakingValidator {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type FetchProposersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of upcoming proposers, defaults to 1
+	Times uint64 `protobuf:"varint,1,opt,name=Times,proto3" json:"Times,omitempty"`
+}
+
+func (x *FetchProposersRequest) Reset() {
+	*x = FetchProposersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchProposersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchProposersRequest) ProtoMessage() {}
+
+func (x *FetchProposersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchProposersRequest.ProtoReflect.Descriptor instead.
+func (*FetchProposersRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FetchProposersRequest) GetTimes() uint64 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+type FetchProposersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string              `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result []*StakingValidator `protobuf:"bytes,2,rep,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchProposersResponse) Reset() {
+	*x = FetchProposersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchProposersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchProposersResponse) ProtoMessage() {}
+
+func (x *FetchProposersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchProposersResponse.ProtoReflect.Descriptor instead.
+func (*FetchProposersResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FetchProposersResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchProposersResponse) GetResult() []*StakingValidator {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type FetchSigningInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorID uint64 `protobuf:"varint,1,opt,name=ValidatorID,proto3" json:"ValidatorID,omitempty"`
+}
+
+func (x *FetchSigningInfoRequest) Reset() {
+	*x = FetchSigningInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchSigningInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchSigningInfoRequest) ProtoMessage() {}
+
+func (x *FetchSigningInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchSigningInfoRequest.ProtoReflect.Descriptor instead.
+func (*FetchSigningInfoRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FetchSigningInfoRequest) GetValidatorID() uint64 {
+	if x != nil {
+		return x.ValidatorID
+	}
+	return 0
+}
+
+type FetchSigningInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string       `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result *SigningInfo `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FetchSigningInfoResponse) Reset() {
+	*x = FetchSigningInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_staking_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchSigningInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchSigningInfoResponse) ProtoMessage() {}
+
+func (x *FetchSigningInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_staking_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchSigningInfoResponse.ProtoReflect.Descriptor instead.
+func (*FetchSigningInfoResponse) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_staking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FetchSigningInfoResponse) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *FetchSigningInfoResponse) GetResult() *SigningInfo {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_server_gRPC_pb_staking_proto protoreflect.FileDescriptor
+
+var file_server_gRPC_pb_staking_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x52, 0x50, 0x43, 0x2f, 0x70, 0x62,
+	0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e,
+	0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x45, 0x6e,
+	0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x2e, 0x48, 0x31, 0x36, 0x30, 0x52, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0a,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x3d, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x10, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x71, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x31,
+	0x36, 0x30, 0x52, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x6b, 0x0a, 0x16, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64,
+	0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x3b, 0x0a, 0x17, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
+	0x22, 0x68, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x85, 0x04, 0x0a, 0x0f, 0x48,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x59,
+	0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x14, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27,
+	0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x17, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x2e, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x68, 0x65,
+	0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x52,
+	0x50, 0x43, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_server_gRPC_pb_staking_proto_rawDescOnce sync.Once
+	file_server_gRPC_pb_staking_proto_rawDescData = file_server_gRPC_pb_staking_proto_rawDesc
+)
+
+func file_server_gRPC_pb_staking_proto_rawDescGZIP() []byte {
+	file_server_gRPC_pb_staking_proto_rawDescOnce.Do(func() {
+		file_server_gRPC_pb_staking_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_gRPC_pb_staking_proto_rawDescData)
+	})
+	return file_server_gRPC_pb_staking_proto_rawDescData
+}
+
+var file_server_gRPC_pb_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_server_gRPC_pb_staking_proto_goTypes = []interface{}{
+	(*StakingValidator)(nil),          // 0: heimdall.server.StakingValidator
+	(*StakingValidatorSet)(nil),       // 1: heimdall.server.StakingValidatorSet
+	(*SigningInfo)(nil),               // 2: heimdall.server.SigningInfo
+	(*FetchValidatorSetResponse)(nil), // 3: heimdall.server.FetchValidatorSetResponse
+	(*FetchValidatorRequest)(nil),     // 4: heimdall.server.FetchValidatorRequest
+	(*FetchValidatorResponse)(nil),    // 5: heimdall.server.FetchValidatorResponse
+	(*FetchProposersRequest)(nil),     // 6: heimdall.server.FetchProposersRequest
+	(*FetchProposersResponse)(nil),    // 7: heimdall.server.FetchProposersResponse
+	(*FetchSigningInfoRequest)(nil),   // 8: heimdall.server.FetchSigningInfoRequest
+	(*FetchSigningInfoResponse)(nil),  // 9: heimdall.server.FetchSigningInfoResponse
+	(*heimdall.H160)(nil),             // 10: heimdall.H160
+	(*emptypb.Empty)(nil),             // 11: google.protobuf.Empty
+}
+var file_server_gRPC_pb_staking_proto_depIdxs = []int32{
+	10, // 0: heimdall.server.StakingValidator.Signer:type_name -> heimdall.H160
+	0,  // 1: heimdall.server.StakingValidatorSet.Validators:type_name -> heimdall.server.StakingValidator
+	0,  // 2: heimdall.server.StakingValidatorSet.Proposer:type_name -> heimdall.server.StakingValidator
+	1,  // 3: heimdall.server.FetchValidatorSetResponse.Result:type_name -> heimdall.server.StakingValidatorSet
+	10, // 4: heimdall.server.FetchValidatorRequest.Signer:type_name -> heimdall.H160
+	0,  // 5: heimdall.server.FetchValidatorResponse.Result:type_name -> heimdall.server.StakingValidator
+	0,  // 6: heimdall.server.FetchProposersResponse.Result:type_name -> heimdall.server.StakingValidator
+	2,  // 7: heimdall.server.FetchSigningInfoResponse.Result:type_name -> heimdall.server.SigningInfo
+	11, // 8: heimdall.server.HeimdallStaking.FetchValidatorSet:input_type -> google.protobuf.Empty
+	4,  // 9: heimdall.server.HeimdallStaking.FetchValidator:input_type -> heimdall.server.FetchValidatorRequest
+	11, // 10: heimdall.server.HeimdallStaking.FetchCurrentProposer:input_type -> google.protobuf.Empty
+	6,  // 11: heimdall.server.HeimdallStaking.FetchMilestoneProposers:input_type -> heimdall.server.FetchProposersRequest
+	8,  // 12: heimdall.server.HeimdallStaking.FetchSigningInfo:input_type -> heimdall.server.FetchSigningInfoRequest
+	3,  // 13: heimdall.server.HeimdallStaking.FetchValidatorSet:output_type -> heimdall.server.FetchValidatorSetResponse
+	5,  // 14: heimdall.server.HeimdallStaking.FetchValidator:output_type -> heimdall.server.FetchValidatorResponse
+	5,  // 15: heimdall.server.HeimdallStaking.FetchCurrentProposer:output_type -> heimdall.server.FetchValidatorResponse
+	7,  // 16: heimdall.server.HeimdallStaking.FetchMilestoneProposers:output_type -> heimdall.server.FetchProposersResponse
+	9,  // 17: heimdall.server.HeimdallStaking.FetchSigningInfo:output_type -> heimdall.server.FetchSigningInfoResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_server_gRPC_pb_staking_proto_init() }
+func file_server_gRPC_pb_staking_proto_init() {
+	if File_server_gRPC_pb_staking_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_server_gRPC_pb_staking_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingValidatorSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchValidatorSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchValidatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchValidatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchProposersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchProposersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchSigningInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_staking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchSigningInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_server_gRPC_pb_staking_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_server_gRPC_pb_staking_proto_goTypes,
+		DependencyIndexes: file_server_gRPC_pb_staking_proto_depIdxs,
+		MessageInfos:      file_server_gRPC_pb_staking_proto_msgTypes,
+	}.Build()
+	File_server_gRPC_pb_staking_proto = out.File
+	file_server_gRPC_pb_staking_proto_rawDesc = nil
+	file_server_gRPC_pb_staking_proto_goTypes = nil
+	file_server_gRPC_pb_staking_proto_depIdxs = nil
+}
@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: server/gRPC/pb/staking.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// HeimdallStakingClient is the client API for HeimdallStaking service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HeimdallStakingClient interface {
+	FetchValidatorSet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FetchValidatorSetResponse, error)
+	FetchValidator(ctx context.Context, in *FetchValidatorRequest, opts ...grpc.CallOption) (*FetchValidatorResponse, error)
+	FetchCurrentProposer(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FetchValidatorResponse, error)
+	FetchMilestoneProposers(ctx context.Context, in *FetchProposersRequest, opts ...grpc.CallOption) (*FetchProposersResponse, error)
+	FetchSigningInfo(ctx context.Context, in *FetchSigningInfoRequest, opts ...grpc.CallOption) (*FetchSigningInfoResponse, error)
+}
+
+type heimdallStakingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeimdallStakingClient(cc grpc.ClientConnInterface) HeimdallStakingClient {
+	return &heimdallStakingClient{cc}
+}
+
+func (c *heimdallStakingClient) FetchValidatorSet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FetchValidatorSetResponse, error) {
+	out := new(FetchValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallStaking/FetchValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallStakingClient) FetchValidator(ctx context.Context, in *FetchValidatorRequest, opts ...grpc.CallOption) (*FetchValidatorResponse, error) {
+	out := new(FetchValidatorResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallStaking/FetchValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallStakingClient) FetchCurrentProposer(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FetchValidatorResponse, error) {
+	out := new(FetchValidatorResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallStaking/FetchCurrentProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallStakingClient) FetchMilestoneProposers(ctx context.Context, in *FetchProposersRequest, opts ...grpc.CallOption) (*FetchProposersResponse, error) {
+	out := new(FetchProposersResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallStaking/FetchMilestoneProposers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heimdallStakingClient) FetchSigningInfo(ctx context.Context, in *FetchSigningInfoRequest, opts ...grpc.CallOption) (*FetchSigningInfoResponse, error) {
+	out := new(FetchSigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.server.HeimdallStaking/FetchSigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HeimdallStakingServer is the server API for HeimdallStaking service.
+// All implementations must embed UnimplementedHeimdallStakingServer
+// for forward compatibility
+type HeimdallStakingServer interface {
+	FetchValidatorSet(context.Context, *emptypb.Empty) (*FetchValidatorSetResponse, error)
+	FetchValidator(context.Context, *FetchValidatorRequest) (*FetchValidatorResponse, error)
+	FetchCurrentProposer(context.Context, *emptypb.Empty) (*FetchValidatorResponse, error)
+	FetchMilestoneProposers(context.Context, *FetchProposersRequest) (*FetchProposersResponse, error)
+	FetchSigningInfo(context.Context, *FetchSigningInfoRequest) (*FetchSigningInfoResponse, error)
+	mustEmbedUnimplementedHeimdallStakingServer()
+}
+
+// UnimplementedHeimdallStakingServer must be embedded to have forward compatible implementations.
+type UnimplementedHeimdallStakingServer struct {
+}
+
+func (UnimplementedHeimdallStakingServer) FetchValidatorSet(context.Context, *emptypb.Empty) (*FetchValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchValidatorSet not implemented")
+}
+func (UnimplementedHeimdallStakingServer) FetchValidator(context.Context, *FetchValidatorRequest) (*FetchValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchValidator not implemented")
+}
+func (UnimplementedHeimdallStakingServer) FetchCurrentProposer(context.Context, *emptypb.Empty) (*FetchValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchCurrentProposer not implemented")
+}
+func (UnimplementedHeimdallStakingServer) FetchMilestoneProposers(context.Context, *FetchProposersRequest) (*FetchProposersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMilestoneProposers not implemented")
+}
+func (UnimplementedHeimdallStakingServer) FetchSigningInfo(context.Context, *FetchSigningInfoRequest) (*FetchSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchSigningInfo not implemented")
+}
+func (UnimplementedHeimdallStakingServer) mustEmbedUnimplementedHeimdallStakingServer() {}
+
+// UnsafeHeimdallStakingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HeimdallStakingServer will
+// result in compilation errors.
+type UnsafeHeimdallStakingServer interface {
+	mustEmbedUnimplementedHeimdallStakingServer()
+}
+
+func RegisterHeimdallStakingServer(s grpc.ServiceRegistrar, srv HeimdallStakingServer) {
+	s.RegisterService(&HeimdallStaking_ServiceDesc, srv)
+}
+
+func _HeimdallStaking_FetchValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallStakingServer).FetchValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallStaking/FetchValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallStakingServer).FetchValidatorSet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallStaking_FetchValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallStakingServer).FetchValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallStaking/FetchValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallStakingServer).FetchValidator(ctx, req.(*FetchValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallStaking_FetchCurrentProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallStakingServer).FetchCurrentProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallStaking/FetchCurrentProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallStakingServer).FetchCurrentProposer(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallStaking_FetchMilestoneProposers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchProposersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallStakingServer).FetchMilestoneProposers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallStaking/FetchMilestoneProposers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallStakingServer).FetchMilestoneProposers(ctx, req.(*FetchProposersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeimdallStaking_FetchSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeimdallStakingServer).FetchSigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.server.HeimdallStaking/FetchSigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeimdallStakingServer).FetchSigningInfo(ctx, req.(*FetchSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HeimdallStaking_ServiceDesc is the grpc.ServiceDesc for HeimdallStaking service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HeimdallStaking_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.server.HeimdallStaking",
+	HandlerType: (*HeimdallStakingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchValidatorSet",
+			Handler:    _HeimdallStaking_FetchValidatorSet_Handler,
+		},
+		{
+			MethodName: "FetchValidator",
+			Handler:    _HeimdallStaking_FetchValidator_Handler,
+		},
+		{
+			MethodName: "FetchCurrentProposer",
+			Handler:    _HeimdallStaking_FetchCurrentProposer_Handler,
+		},
+		{
+			MethodName: "FetchMilestoneProposers",
+			Handler:    _HeimdallStaking_FetchMilestoneProposers_Handler,
+		},
+		{
+			MethodName: "FetchSigningInfo",
+			Handler:    _HeimdallStaking_FetchSigningInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/gRPC/pb/staking.proto",
+}
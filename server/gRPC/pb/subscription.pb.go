@@ -0,0 +1,741 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: server/gRPC/pb/subscription.proto
+
+package pb
+
+import (
+	heimdall "github.com/maticnetwork/polyproto/heimdall"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscribeCheckpointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// checkpoint number to resume from, 0 only streams new checkpoints
+	FromNumber uint64 `protobuf:"varint,1,opt,name=FromNumber,proto3" json:"FromNumber,omitempty"`
+}
+
+func (x *SubscribeCheckpointsRequest) Reset() {
+	*x = SubscribeCheckpointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeCheckpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeCheckpointsRequest) ProtoMessage() {}
+
+func (x *SubscribeCheckpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeCheckpointsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeCheckpointsRequest) GetFromNumber() uint64 {
+	if x != nil {
+		return x.FromNumber
+	}
+	return 0
+}
+
+type CheckpointEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string               `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Number uint64               `protobuf:"varint,2,opt,name=Number,proto3" json:"Number,omitempty"`
+	Result *heimdall.Checkpoint `protobuf:"bytes,3,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *CheckpointEvent) Reset() {
+	*x = CheckpointEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointEvent) ProtoMessage() {}
+
+func (x *CheckpointEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointEvent.ProtoReflect.Descriptor instead.
+func (*CheckpointEvent) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckpointEvent) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *CheckpointEvent) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CheckpointEvent) GetResult() *heimdall.Checkpoint {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type SubscribeMilestonesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// milestone count to resume from, 0 only streams new milestones
+	FromCount uint64 `protobuf:"varint,1,opt,name=FromCount,proto3" json:"FromCount,omitempty"`
+}
+
+func (x *SubscribeMilestonesRequest) Reset() {
+	*x = SubscribeMilestonesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeMilestonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMilestonesRequest) ProtoMessage() {}
+
+func (x *SubscribeMilestonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMilestonesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMilestonesRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubscribeMilestonesRequest) GetFromCount() uint64 {
+	if x != nil {
+		return x.FromCount
+	}
+	return 0
+}
+
+type MilestoneEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height      string              `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Count       uint64              `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	MilestoneID string              `protobuf:"bytes,3,opt,name=MilestoneID,proto3" json:"MilestoneID,omitempty"`
+	Result      *heimdall.Milestone `protobuf:"bytes,4,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *MilestoneEvent) Reset() {
+	*x = MilestoneEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MilestoneEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MilestoneEvent) ProtoMessage() {}
+
+func (x *MilestoneEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MilestoneEvent.ProtoReflect.Descriptor instead.
+func (*MilestoneEvent) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MilestoneEvent) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *MilestoneEvent) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MilestoneEvent) GetMilestoneID() string {
+	if x != nil {
+		return x.MilestoneID
+	}
+	return ""
+}
+
+func (x *MilestoneEvent) GetResult() *heimdall.Milestone {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type SubscribeNoAckMilestonesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block height to resume from, 0 only streams new no-ack milestones
+	FromHeight uint64 `protobuf:"varint,1,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+}
+
+func (x *SubscribeNoAckMilestonesRequest) Reset() {
+	*x = SubscribeNoAckMilestonesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeNoAckMilestonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeNoAckMilestonesRequest) ProtoMessage() {}
+
+func (x *SubscribeNoAckMilestonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeNoAckMilestonesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeNoAckMilestonesRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscribeNoAckMilestonesRequest) GetFromHeight() uint64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+type NoAckMilestoneEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height      string         `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	MilestoneID string         `protobuf:"bytes,2,opt,name=MilestoneID,proto3" json:"MilestoneID,omitempty"`
+	StartBlock  uint64         `protobuf:"varint,3,opt,name=StartBlock,proto3" json:"StartBlock,omitempty"`
+	EndBlock    uint64         `protobuf:"varint,4,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
+	Proposer    *heimdall.H160 `protobuf:"bytes,5,opt,name=Proposer,proto3" json:"Proposer,omitempty"`
+}
+
+func (x *NoAckMilestoneEvent) Reset() {
+	*x = NoAckMilestoneEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoAckMilestoneEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoAckMilestoneEvent) ProtoMessage() {}
+
+func (x *NoAckMilestoneEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoAckMilestoneEvent.ProtoReflect.Descriptor instead.
+func (*NoAckMilestoneEvent) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NoAckMilestoneEvent) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *NoAckMilestoneEvent) GetMilestoneID() string {
+	if x != nil {
+		return x.MilestoneID
+	}
+	return ""
+}
+
+func (x *NoAckMilestoneEvent) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *NoAckMilestoneEvent) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *NoAckMilestoneEvent) GetProposer() *heimdall.H160 {
+	if x != nil {
+		return x.Proposer
+	}
+	return nil
+}
+
+type SubscribeSpansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// span id to resume from, 0 only streams new spans
+	FromID uint64 `protobuf:"varint,1,opt,name=FromID,proto3" json:"FromID,omitempty"`
+}
+
+func (x *SubscribeSpansRequest) Reset() {
+	*x = SubscribeSpansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSpansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSpansRequest) ProtoMessage() {}
+
+func (x *SubscribeSpansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSpansRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSpansRequest) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubscribeSpansRequest) GetFromID() uint64 {
+	if x != nil {
+		return x.FromID
+	}
+	return 0
+}
+
+type SpanEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height string         `protobuf:"bytes,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Result *heimdall.Span `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *SpanEvent) Reset() {
+	*x = SpanEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_gRPC_pb_subscription_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpanEvent) ProtoMessage() {}
+
+func (x *SpanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_server_gRPC_pb_subscription_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpanEvent.ProtoReflect.Descriptor instead.
+func (*SpanEvent) Descriptor() ([]byte, []int) {
+	return file_server_gRPC_pb_subscription_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SpanEvent) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *SpanEvent) GetResult() *heimdall.Span {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_server_gRPC_pb_subscription_proto protoreflect.FileDescriptor
+
+var file_server_gRPC_pb_subscription_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x52, 0x50, 0x43, 0x2f, 0x70, 0x62,
+	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x1a, 0x17, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2f, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a,
+	0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x0f,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x2c, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x0a,
+	0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x46,
+	0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x1f, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4e, 0x6f, 0x41, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb7, 0x01, 0x0a,
+	0x13, 0x4e, 0x6f, 0x41, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x31, 0x36, 0x30, 0x52, 0x08, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x2f, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x4b, 0x0a, 0x09, 0x53, 0x70, 0x61, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x32, 0xbd, 0x03, 0x0a, 0x14, 0x48, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6a, 0x0a,
+	0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x13, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73,
+	0x12, 0x2b, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6c, 0x65,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x76, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4e,
+	0x6f, 0x41, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x30,
+	0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4e, 0x6f, 0x41, 0x63, 0x6b, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x41, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x0e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x68,
+	0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x00, 0x30, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x68, 0x65, 0x69, 0x6d, 0x64, 0x61, 0x6c, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x67, 0x52, 0x50, 0x43, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_server_gRPC_pb_subscription_proto_rawDescOnce sync.Once
+	file_server_gRPC_pb_subscription_proto_rawDescData = file_server_gRPC_pb_subscription_proto_rawDesc
+)
+
+func file_server_gRPC_pb_subscription_proto_rawDescGZIP() []byte {
+	file_server_gRPC_pb_subscription_proto_rawDescOnce.Do(func() {
+		file_server_gRPC_pb_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_gRPC_pb_subscription_proto_rawDescData)
+	})
+	return file_server_gRPC_pb_subscription_proto_rawDescData
+}
+
+var file_server_gRPC_pb_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_server_gRPC_pb_subscription_proto_goTypes = []interface{}{
+	(*SubscribeCheckpointsRequest)(nil),     // 0: heimdall.server.SubscribeCheckpointsRequest
+	(*CheckpointEvent)(nil),                 // 1: heimdall.server.CheckpointEvent
+	(*SubscribeMilestonesRequest)(nil),      // 2: heimdall.server.SubscribeMilestonesRequest
+	(*MilestoneEvent)(nil),                  // 3: heimdall.server.MilestoneEvent
+	(*SubscribeNoAckMilestonesRequest)(nil), // 4: heimdall.server.SubscribeNoAckMilestonesRequest
+	(*NoAckMilestoneEvent)(nil),             // 5: heimdall.server.NoAckMilestoneEvent
+	(*SubscribeSpansRequest)(nil),           // 6: heimdall.server.SubscribeSpansRequest
+	(*SpanEvent)(nil),                       // 7: heimdall.server.SpanEvent
+	(*heimdall.Checkpoint)(nil),             // 8: heimdall.Checkpoint
+	(*heimdall.Milestone)(nil),              // 9: heimdall.Milestone
+	(*heimdall.H160)(nil),                   // 10: heimdall.H160
+	(*heimdall.Span)(nil),                   // 11: heimdall.Span
+}
+var file_server_gRPC_pb_subscription_proto_depIdxs = []int32{
+	8,  // 0: heimdall.server.CheckpointEvent.Result:type_name -> heimdall.Checkpoint
+	9,  // 1: heimdall.server.MilestoneEvent.Result:type_name -> heimdall.Milestone
+	10, // 2: heimdall.server.NoAckMilestoneEvent.Proposer:type_name -> heimdall.H160
+	11, // 3: heimdall.server.SpanEvent.Result:type_name -> heimdall.Span
+	0,  // 4: heimdall.server.HeimdallSubscription.SubscribeCheckpoints:input_type -> heimdall.server.SubscribeCheckpointsRequest
+	2,  // 5: heimdall.server.HeimdallSubscription.SubscribeMilestones:input_type -> heimdall.server.SubscribeMilestonesRequest
+	4,  // 6: heimdall.server.HeimdallSubscription.SubscribeNoAckMilestones:input_type -> heimdall.server.SubscribeNoAckMilestonesRequest
+	6,  // 7: heimdall.server.HeimdallSubscription.SubscribeSpans:input_type -> heimdall.server.SubscribeSpansRequest
+	1,  // 8: heimdall.server.HeimdallSubscription.SubscribeCheckpoints:output_type -> heimdall.server.CheckpointEvent
+	3,  // 9: heimdall.server.HeimdallSubscription.SubscribeMilestones:output_type -> heimdall.server.MilestoneEvent
+	5,  // 10: heimdall.server.HeimdallSubscription.SubscribeNoAckMilestones:output_type -> heimdall.server.NoAckMilestoneEvent
+	7,  // 11: heimdall.server.HeimdallSubscription.SubscribeSpans:output_type -> heimdall.server.SpanEvent
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_server_gRPC_pb_subscription_proto_init() }
+func file_server_gRPC_pb_subscription_proto_init() {
+	if File_server_gRPC_pb_subscription_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_server_gRPC_pb_subscription_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeCheckpointsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_subscription_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_subscription_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMilestonesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_subscription_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MilestoneEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_subscription_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeNoAckMilestonesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_subscription_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoAckMilestoneEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_subscription_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSpansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_gRPC_pb_subscription_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpanEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_server_gRPC_pb_subscription_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_server_gRPC_pb_subscription_proto_goTypes,
+		DependencyIndexes: file_server_gRPC_pb_subscription_proto_depIdxs,
+		MessageInfos:      file_server_gRPC_pb_subscription_proto_msgTypes,
+	}.Build()
+	File_server_gRPC_pb_subscription_proto = out.File
+	file_server_gRPC_pb_subscription_proto_rawDesc = nil
+	file_server_gRPC_pb_subscription_proto_goTypes = nil
+	file_server_gRPC_pb_subscription_proto_depIdxs = nil
+}
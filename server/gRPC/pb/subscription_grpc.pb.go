@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: server/gRPC/pb/subscription.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// HeimdallSubscriptionClient is the client API for HeimdallSubscription service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HeimdallSubscriptionClient interface {
+	SubscribeCheckpoints(ctx context.Context, in *SubscribeCheckpointsRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeCheckpointsClient, error)
+	SubscribeMilestones(ctx context.Context, in *SubscribeMilestonesRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeMilestonesClient, error)
+	SubscribeNoAckMilestones(ctx context.Context, in *SubscribeNoAckMilestonesRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeNoAckMilestonesClient, error)
+	SubscribeSpans(ctx context.Context, in *SubscribeSpansRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeSpansClient, error)
+}
+
+type heimdallSubscriptionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeimdallSubscriptionClient(cc grpc.ClientConnInterface) HeimdallSubscriptionClient {
+	return &heimdallSubscriptionClient{cc}
+}
+
+func (c *heimdallSubscriptionClient) SubscribeCheckpoints(ctx context.Context, in *SubscribeCheckpointsRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeCheckpointsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HeimdallSubscription_ServiceDesc.Streams[0], "/heimdall.server.HeimdallSubscription/SubscribeCheckpoints", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &heimdallSubscriptionSubscribeCheckpointsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HeimdallSubscription_SubscribeCheckpointsClient interface {
+	Recv() (*CheckpointEvent, error)
+	grpc.ClientStream
+}
+
+type heimdallSubscriptionSubscribeCheckpointsClient struct {
+	grpc.ClientStream
+}
+
+func (x *heimdallSubscriptionSubscribeCheckpointsClient) Recv() (*CheckpointEvent, error) {
+	m := new(CheckpointEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *heimdallSubscriptionClient) SubscribeMilestones(ctx context.Context, in *SubscribeMilestonesRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeMilestonesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HeimdallSubscription_ServiceDesc.Streams[1], "/heimdall.server.HeimdallSubscription/SubscribeMilestones", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &heimdallSubscriptionSubscribeMilestonesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HeimdallSubscription_SubscribeMilestonesClient interface {
+	Recv() (*MilestoneEvent, error)
+	grpc.ClientStream
+}
+
+type heimdallSubscriptionSubscribeMilestonesClient struct {
+	grpc.ClientStream
+}
+
+func (x *heimdallSubscriptionSubscribeMilestonesClient) Recv() (*MilestoneEvent, error) {
+	m := new(MilestoneEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *heimdallSubscriptionClient) SubscribeNoAckMilestones(ctx context.Context, in *SubscribeNoAckMilestonesRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeNoAckMilestonesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HeimdallSubscription_ServiceDesc.Streams[2], "/heimdall.server.HeimdallSubscription/SubscribeNoAckMilestones", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &heimdallSubscriptionSubscribeNoAckMilestonesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HeimdallSubscription_SubscribeNoAckMilestonesClient interface {
+	Recv() (*NoAckMilestoneEvent, error)
+	grpc.ClientStream
+}
+
+type heimdallSubscriptionSubscribeNoAckMilestonesClient struct {
+	grpc.ClientStream
+}
+
+func (x *heimdallSubscriptionSubscribeNoAckMilestonesClient) Recv() (*NoAckMilestoneEvent, error) {
+	m := new(NoAckMilestoneEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *heimdallSubscriptionClient) SubscribeSpans(ctx context.Context, in *SubscribeSpansRequest, opts ...grpc.CallOption) (HeimdallSubscription_SubscribeSpansClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HeimdallSubscription_ServiceDesc.Streams[3], "/heimdall.server.HeimdallSubscription/SubscribeSpans", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &heimdallSubscriptionSubscribeSpansClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HeimdallSubscription_SubscribeSpansClient interface {
+	Recv() (*SpanEvent, error)
+	grpc.ClientStream
+}
+
+type heimdallSubscriptionSubscribeSpansClient struct {
+	grpc.ClientStream
+}
+
+func (x *heimdallSubscriptionSubscribeSpansClient) Recv() (*SpanEvent, error) {
+	m := new(SpanEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HeimdallSubscriptionServer is the server API for HeimdallSubscription service.
+// All implementations must embed UnimplementedHeimdallSubscriptionServer
+// for forward compatibility
+type HeimdallSubscriptionServer interface {
+	SubscribeCheckpoints(*SubscribeCheckpointsRequest, HeimdallSubscription_SubscribeCheckpointsServer) error
+	SubscribeMilestones(*SubscribeMilestonesRequest, HeimdallSubscription_SubscribeMilestonesServer) error
+	SubscribeNoAckMilestones(*SubscribeNoAckMilestonesRequest, HeimdallSubscription_SubscribeNoAckMilestonesServer) error
+	SubscribeSpans(*SubscribeSpansRequest, HeimdallSubscription_SubscribeSpansServer) error
+	mustEmbedUnimplementedHeimdallSubscriptionServer()
+}
+
+// UnimplementedHeimdallSubscriptionServer must be embedded to have forward compatible implementations.
+type UnimplementedHeimdallSubscriptionServer struct {
+}
+
+func (UnimplementedHeimdallSubscriptionServer) SubscribeCheckpoints(*SubscribeCheckpointsRequest, HeimdallSubscription_SubscribeCheckpointsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeCheckpoints not implemented")
+}
+func (UnimplementedHeimdallSubscriptionServer) SubscribeMilestones(*SubscribeMilestonesRequest, HeimdallSubscription_SubscribeMilestonesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMilestones not implemented")
+}
+func (UnimplementedHeimdallSubscriptionServer) SubscribeNoAckMilestones(*SubscribeNoAckMilestonesRequest, HeimdallSubscription_SubscribeNoAckMilestonesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNoAckMilestones not implemented")
+}
+func (UnimplementedHeimdallSubscriptionServer) SubscribeSpans(*SubscribeSpansRequest, HeimdallSubscription_SubscribeSpansServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSpans not implemented")
+}
+func (UnimplementedHeimdallSubscriptionServer) mustEmbedUnimplementedHeimdallSubscriptionServer() {}
+
+// UnsafeHeimdallSubscriptionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HeimdallSubscriptionServer will
+// result in compilation errors.
+type UnsafeHeimdallSubscriptionServer interface {
+	mustEmbedUnimplementedHeimdallSubscriptionServer()
+}
+
+func RegisterHeimdallSubscriptionServer(s grpc.ServiceRegistrar, srv HeimdallSubscriptionServer) {
+	s.RegisterService(&HeimdallSubscription_ServiceDesc, srv)
+}
+
+func _HeimdallSubscription_SubscribeCheckpoints_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCheckpointsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeimdallSubscriptionServer).SubscribeCheckpoints(m, &heimdallSubscriptionSubscribeCheckpointsServer{stream})
+}
+
+type HeimdallSubscription_SubscribeCheckpointsServer interface {
+	Send(*CheckpointEvent) error
+	grpc.ServerStream
+}
+
+type heimdallSubscriptionSubscribeCheckpointsServer struct {
+	grpc.ServerStream
+}
+
+func (x *heimdallSubscriptionSubscribeCheckpointsServer) Send(m *CheckpointEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HeimdallSubscription_SubscribeMilestones_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMilestonesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeimdallSubscriptionServer).SubscribeMilestones(m, &heimdallSubscriptionSubscribeMilestonesServer{stream})
+}
+
+type HeimdallSubscription_SubscribeMilestonesServer interface {
+	Send(*MilestoneEvent) error
+	grpc.ServerStream
+}
+
+type heimdallSubscriptionSubscribeMilestonesServer struct {
+	grpc.ServerStream
+}
+
+func (x *heimdallSubscriptionSubscribeMilestonesServer) Send(m *MilestoneEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HeimdallSubscription_SubscribeNoAckMilestones_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeNoAckMilestonesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeimdallSubscriptionServer).SubscribeNoAckMilestones(m, &heimdallSubscriptionSubscribeNoAckMilestonesServer{stream})
+}
+
+type HeimdallSubscription_SubscribeNoAckMilestonesServer interface {
+	Send(*NoAckMilestoneEvent) error
+	grpc.ServerStream
+}
+
+type heimdallSubscriptionSubscribeNoAckMilestonesServer struct {
+	grpc.ServerStream
+}
+
+func (x *heimdallSubscriptionSubscribeNoAckMilestonesServer) Send(m *NoAckMilestoneEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HeimdallSubscription_SubscribeSpans_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSpansRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeimdallSubscriptionServer).SubscribeSpans(m, &heimdallSubscriptionSubscribeSpansServer{stream})
+}
+
+type HeimdallSubscription_SubscribeSpansServer interface {
+	Send(*SpanEvent) error
+	grpc.ServerStream
+}
+
+type heimdallSubscriptionSubscribeSpansServer struct {
+	grpc.ServerStream
+}
+
+func (x *heimdallSubscriptionSubscribeSpansServer) Send(m *SpanEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// HeimdallSubscription_ServiceDesc is the grpc.ServiceDesc for HeimdallSubscription service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HeimdallSubscription_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.server.HeimdallSubscription",
+	HandlerType: (*HeimdallSubscriptionServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeCheckpoints",
+			Handler:       _HeimdallSubscription_SubscribeCheckpoints_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeMilestones",
+			Handler:       _HeimdallSubscription_SubscribeMilestones_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNoAckMilestones",
+			Handler:       _HeimdallSubscription_SubscribeNoAckMilestones_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeSpans",
+			Handler:       _HeimdallSubscription_SubscribeSpans_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server/gRPC/pb/subscription.proto",
+}
@@ -0,0 +1,116 @@
+package gRPC
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/maticnetwork/heimdall/common"
+)
+
+// blockHeightHeader is the request metadata key used to pin a query to a
+// heimdall height, same as the cosmos gRPC gateway
+const blockHeightHeader = "x-cosmos-block-height"
+
+type queryHeightKey struct{}
+
+// abciQueryError is the JSON log attached by the application to a failed ABCI query
+type abciQueryError struct {
+	Codespace string       `json:"codespace"`
+	Code      sdk.CodeType `json:"code"`
+	Message   string       `json:"message"`
+}
+
+// notFoundCodes are the module error codes reported for missing objects
+var notFoundCodes = map[sdk.CodeType]bool{
+	common.CodeNoCheckpoint:       true,
+	common.CodeNoCheckpointBuffer: true,
+	common.CodeNoMilestone:        true,
+	common.CodeSpanNotFound:       true,
+	common.CodeNoValidator:        true,
+	common.CodeNoSigningInfo:      true,
+}
+
+// withQueryHeight reads the optional block height from the request metadata
+// and stores it in the context for the queries of the request
+func withQueryHeight(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	values := md.Get(blockHeightHeader)
+	if len(values) == 0 {
+		return ctx, nil
+	}
+
+	height, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || height < 0 {
+		return ctx, status.Errorf(codes.InvalidArgument, "invalid %s: %s", blockHeightHeader, values[0])
+	}
+
+	return context.WithValue(ctx, queryHeightKey{}, height), nil
+}
+
+// queryHeight returns the height requested for the queries of the request,
+// 0 queries the latest height
+func queryHeight(ctx context.Context) int64 {
+	height, _ := ctx.Value(queryHeightKey{}).(int64)
+	return height
+}
+
+// requireLatestHeight rejects requests pinned to a height, for RPCs which only
+// serve the live state
+func requireLatestHeight(ctx context.Context) error {
+	if height := queryHeight(ctx); height != 0 {
+		return status.Errorf(codes.InvalidArgument, "%s is not supported by this method", blockHeightHeader)
+	}
+
+	return nil
+}
+
+// query runs a custom query against the querier of the given module at the
+// height requested in the context, and returns the raw result along with the
+// height it was served at
+func (h *HeimdallGRPCServer) query(ctx context.Context, querierRoute string, path string, data []byte) ([]byte, int64, error) {
+	cliCtx := h.cliCtx.WithHeight(queryHeight(ctx))
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", querierRoute, path), data)
+	if err != nil {
+		return nil, height, toStatusError(err)
+	}
+
+	if len(res) == 0 {
+		return nil, height, status.Errorf(codes.NotFound, "no result found for %s/%s", querierRoute, path)
+	}
+
+	return res, height, nil
+}
+
+// toStatusError converts an ABCI query error into a gRPC status error
+func toStatusError(err error) error {
+	var queryErr abciQueryError
+	if jsonErr := json.Unmarshal([]byte(err.Error()), &queryErr); jsonErr != nil || queryErr.Code == 0 {
+		// the query never reached the application
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	switch {
+	case queryErr.Code == sdk.CodeInternal && strings.HasPrefix(queryErr.Message, "failed to load state at height"):
+		// the requested height is pruned or ahead of the chain
+		return status.Error(codes.OutOfRange, queryErr.Message)
+	case notFoundCodes[queryErr.Code]:
+		return status.Error(codes.NotFound, queryErr.Message)
+	case queryErr.Code == sdk.CodeUnknownRequest:
+		return status.Error(codes.Unimplemented, queryErr.Message)
+	default:
+		return status.Error(codes.Internal, queryErr.Message)
+	}
+}
@@ -2,41 +2,85 @@ package gRPC
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
-	cliContext "github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/maticnetwork/heimdall/helper"
-	"github.com/maticnetwork/heimdall/types"
+	jsoniter "github.com/json-iterator/go"
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	protoutils "github.com/maticnetwork/polyproto/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	borRest "github.com/maticnetwork/heimdall/bor/client/rest"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/types"
 )
 
 func (h *HeimdallGRPCServer) Span(ctx context.Context, in *proto.SpanRequest) (*proto.SpanResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(spanURL, in.ID)))
-
+	span, height, err := h.fetchSpan(ctx, in.ID)
 	if err != nil {
-		logger.Error("Error while fetching span")
+		logger.Error("Error while fetching span", "id", in.ID, "error", err)
 		return nil, err
 	}
 
 	resp := &proto.SpanResponse{}
-	resp.Result = parseSpan(result.Result)
-	resp.Height = fmt.Sprint(result.Height)
+	resp.Result = span
+	resp.Height = fmt.Sprint(height)
 
 	return resp, nil
 }
 
-func parseSpan(result json.RawMessage) *proto.Span {
-	var addr [20]byte
+// fetchSpan returns the span with the given id, honoring the span overrides
+func (h *HeimdallGRPCServer) fetchSpan(ctx context.Context, id uint64) (*proto.Span, int64, error) {
+	var (
+		result []byte
+		height int64
+	)
 
-	span := &types.Span{}
+	if span, ok := borRest.GetSpanOverride(id); ok {
+		result, height = span.Result, span.Height
+	} else {
+		queryParams, err := h.cdc.MarshalJSON(borTypes.NewQuerySpanParams(id))
+		if err != nil {
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
 
-	err := json.Unmarshal(result, span)
+		result, height, err = h.query(ctx, borTypes.QuerierRoute, borTypes.QuerySpan, queryParams)
+		if err != nil {
+			return nil, height, err
+		}
+	}
+
+	span, err := parseSpan(result)
 	if err != nil {
 		logger.Error("Error unmarshalling span", "error", err)
-		return nil
+		return nil, height, status.Error(codes.Internal, err.Error())
+	}
+
+	return span, height, nil
+}
+
+// fetchLatestSpanID returns the id of the latest committed span
+func (h *HeimdallGRPCServer) fetchLatestSpanID(ctx context.Context) (uint64, int64, error) {
+	result, height, err := h.query(ctx, borTypes.QuerierRoute, borTypes.QueryLatestSpan, nil)
+	if err != nil {
+		return 0, height, err
+	}
+
+	var span types.Span
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &span); err != nil {
+		return 0, height, status.Error(codes.Internal, err.Error())
+	}
+
+	return span.ID, height, nil
+}
+
+func parseSpan(result []byte) (*proto.Span, error) {
+	var addr [20]byte
+
+	span := &types.Span{}
+
+	if err := jsoniter.ConfigFastest.Unmarshal(result, span); err != nil {
+		return nil, err
 	}
 
 	resp := &proto.Span{
@@ -61,7 +105,7 @@ func parseSpan(result json.RawMessage) *proto.Span {
 		resp.SelectedProducers = append(resp.SelectedProducers, parseValidator(addr, &span.SelectedProducers[i]))
 	}
 
-	return resp
+	return resp, nil
 }
 
 func parseValidator(address [20]byte, validator *types.Validator) *proto.Validator {
@@ -0,0 +1,194 @@
+package gRPC
+
+import (
+	"context"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	protoutils "github.com/maticnetwork/polyproto/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/maticnetwork/heimdall/server/gRPC/pb"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func (h *HeimdallGRPCServer) FetchValidatorSet(ctx context.Context, in *emptypb.Empty) (*pb.FetchValidatorSetResponse, error) {
+	result, height, err := h.query(ctx, stakingTypes.QuerierRoute, stakingTypes.QueryCurrentValidatorSet, nil)
+	if err != nil {
+		logger.Error("Error while fetching validator set", "error", err)
+		return nil, err
+	}
+
+	var validatorSet hmTypes.ValidatorSet
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &validatorSet); err != nil {
+		logger.Error("Error unmarshalling validator set", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.FetchValidatorSetResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &pb.StakingValidatorSet{
+		Validators:       make([]*pb.StakingValidator, 0, len(validatorSet.Validators)),
+		TotalVotingPower: validatorSet.TotalVotingPower(),
+	}
+
+	for _, validator := range validatorSet.Validators {
+		resp.Result.Validators = append(resp.Result.Validators, parseStakingValidator(validator))
+	}
+
+	if validatorSet.Proposer != nil {
+		resp.Result.Proposer = parseStakingValidator(validatorSet.Proposer)
+	}
+
+	return resp, nil
+}
+
+func (h *HeimdallGRPCServer) FetchValidator(ctx context.Context, in *pb.FetchValidatorRequest) (*pb.FetchValidatorResponse, error) {
+	var (
+		path        string
+		queryParams []byte
+		err         error
+	)
+
+	if in.Signer != nil {
+		signer := protoutils.ConvertH160toAddress(in.Signer)
+		path = stakingTypes.QuerySigner
+		queryParams, err = h.cdc.MarshalJSON(stakingTypes.NewQuerySignerParams(signer[:]))
+	} else {
+		path = stakingTypes.QueryValidator
+		queryParams, err = h.cdc.MarshalJSON(stakingTypes.NewQueryValidatorParams(hmTypes.ValidatorID(in.ID)))
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, height, err := h.query(ctx, stakingTypes.QuerierRoute, path, queryParams)
+	if err != nil {
+		logger.Error("Error while fetching validator", "id", in.ID, "error", err)
+		return nil, err
+	}
+
+	var validator hmTypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &validator); err != nil {
+		logger.Error("Error unmarshalling validator", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.FetchValidatorResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = parseStakingValidator(&validator)
+
+	return resp, nil
+}
+
+func (h *HeimdallGRPCServer) FetchCurrentProposer(ctx context.Context, in *emptypb.Empty) (*pb.FetchValidatorResponse, error) {
+	result, height, err := h.query(ctx, stakingTypes.QuerierRoute, stakingTypes.QueryCurrentProposer, nil)
+	if err != nil {
+		logger.Error("Error while fetching current proposer", "error", err)
+		return nil, err
+	}
+
+	// the querier returns null when there is no validator set yet
+	var proposer *hmTypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &proposer); err != nil {
+		logger.Error("Error unmarshalling current proposer", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if proposer == nil {
+		return nil, status.Error(codes.NotFound, "no current proposer")
+	}
+
+	resp := &pb.FetchValidatorResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = parseStakingValidator(proposer)
+
+	return resp, nil
+}
+
+func (h *HeimdallGRPCServer) FetchMilestoneProposers(ctx context.Context, in *pb.FetchProposersRequest) (*pb.FetchProposersResponse, error) {
+	times := in.Times
+	if times == 0 {
+		times = 1
+	}
+
+	queryParams, err := h.cdc.MarshalJSON(stakingTypes.NewQueryProposerParams(times))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, height, err := h.query(ctx, stakingTypes.QuerierRoute, stakingTypes.QueryMilestoneProposer, queryParams)
+	if err != nil {
+		logger.Error("Error while fetching milestone proposers", "error", err)
+		return nil, err
+	}
+
+	var proposers []hmTypes.Validator
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &proposers); err != nil {
+		logger.Error("Error unmarshalling milestone proposers", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.FetchProposersResponse{}
+	resp.Height = fmt.Sprint(height)
+
+	for i := range proposers {
+		resp.Result = append(resp.Result, parseStakingValidator(&proposers[i]))
+	}
+
+	return resp, nil
+}
+
+func (h *HeimdallGRPCServer) FetchSigningInfo(ctx context.Context, in *pb.FetchSigningInfoRequest) (*pb.FetchSigningInfoResponse, error) {
+	queryParams, err := h.cdc.MarshalJSON(slashingTypes.NewQuerySigningInfoParams(hmTypes.ValidatorID(in.ValidatorID)))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, height, err := h.query(ctx, slashingTypes.QuerierRoute, slashingTypes.QuerySigningInfo, queryParams)
+	if err != nil {
+		logger.Error("Error while fetching signing info", "id", in.ValidatorID, "error", err)
+		return nil, err
+	}
+
+	var signingInfo hmTypes.ValidatorSigningInfo
+	if err := jsoniter.ConfigFastest.Unmarshal(result, &signingInfo); err != nil {
+		logger.Error("Error unmarshalling signing info", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.FetchSigningInfoResponse{}
+	resp.Height = fmt.Sprint(height)
+	resp.Result = &pb.SigningInfo{
+		ValID:               uint64(signingInfo.ValID),
+		StartHeight:         signingInfo.StartHeight,
+		IndexOffset:         signingInfo.IndexOffset,
+		MissedBlocksCounter: signingInfo.MissedBlocksCounter,
+	}
+
+	return resp, nil
+}
+
+func parseStakingValidator(validator *hmTypes.Validator) *pb.StakingValidator {
+	var address [20]byte
+
+	copy(address[:], validator.Signer.Bytes())
+
+	return &pb.StakingValidator{
+		ID:               uint64(validator.ID),
+		StartEpoch:       validator.StartEpoch,
+		EndEpoch:         validator.EndEpoch,
+		Nonce:            validator.Nonce,
+		VotingPower:      validator.VotingPower,
+		PubKey:           validator.PubKey.Bytes(),
+		Signer:           protoutils.ConvertAddressToH160(address),
+		LastUpdated:      validator.LastUpdated,
+		Jailed:           validator.Jailed,
+		ProposerPriority: validator.ProposerPriority,
+	}
+}
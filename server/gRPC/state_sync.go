@@ -1,47 +1,53 @@
 package gRPC
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/url"
 	"time"
 
-	cliContext "github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/maticnetwork/heimdall/helper"
+	jsoniter "github.com/json-iterator/go"
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	clerkRest "github.com/maticnetwork/heimdall/clerk/client/rest"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/helper"
 )
 
-type Event struct {
-	Id         uint64 `json:"id"`
-	Contract   string `json:"contract"`
-	Data       string `json:"data"`
-	TxHash     string `json:"tx_hash"`
-	LogIndex   uint64 `json:"log_index"`
-	BorChainId string `json:"bor_chain_id"`
-	RecordTime string `json:"record_time"`
-}
+// maxStateSyncEventsLimit caps the number of records sent per message,
+// same as the REST event record list
+const maxStateSyncEventsLimit = 50
 
 func (h *HeimdallGRPCServer) StateSyncEvents(req *proto.StateSyncEventsRequest, reply proto.Heimdall_StateSyncEventsServer) error {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
 	fromId := req.FromID
 
-	for {
-		params := map[string]string{
-			"from-id": fmt.Sprint(fromId),
-			"to-time": fmt.Sprint(req.ToTime),
-			"limit":   fmt.Sprint(req.Limit),
-		}
+	height, blockTime, err := h.stateSyncHeight(reply.Context())
+	if err != nil {
+		return err
+	}
 
-		result, err := helper.FetchFromAPI(cliCtx, addParamsToEndpoint(helper.GetHeimdallServerEndpoint(eventRecordList), params))
+	// records after the block time are not final yet
+	if int64(req.ToTime) > blockTime.Unix() {
+		return status.Errorf(codes.FailedPrecondition, "to-time %v is ahead of the block time at height %v", req.ToTime, height)
+	}
+
+	cliCtx := h.cliCtx.WithHeight(height)
+
+	limit := req.Limit
+	if limit > maxStateSyncEventsLimit {
+		limit = maxStateSyncEventsLimit
+	}
+
+	for {
+		result, err := clerkRest.TillTimeRangeQuery(cliCtx, fromId, int64(req.ToTime), limit)
 		if err != nil {
 			logger.Error("Error while fetching event records", "error", err)
-			return status.Errorf(codes.Internal, err.Error())
+			return toStatusError(err)
 		}
 
-		eventRecords, err := parseEvents(result.Result)
+		eventRecords, err := parseEvents(result)
 		if err != nil {
 			logger.Error("Error while parsing event records", "error", err)
 			return status.Errorf(codes.Internal, err.Error())
@@ -52,7 +58,7 @@ func (h *HeimdallGRPCServer) StateSyncEvents(req *proto.StateSyncEventsRequest,
 		}
 
 		err = reply.Send(&proto.StateSyncEventsResponse{
-			Height: fmt.Sprint(result.Height),
+			Height: fmt.Sprint(height),
 			Result: eventRecords,
 		})
 		if err != nil {
@@ -60,16 +66,38 @@ func (h *HeimdallGRPCServer) StateSyncEvents(req *proto.StateSyncEventsRequest,
 			return status.Errorf(codes.Internal, err.Error())
 		}
 
-		fromId += req.Limit
+		fromId += uint64(len(eventRecords))
 	}
 
 	return nil
 }
 
-func parseEvents(result json.RawMessage) ([]*proto.EventRecord, error) {
-	var events []Event
+// stateSyncHeight returns the height the event records are read at, along with
+// its block time. It is the requested height if any, the latest height otherwise.
+func (h *HeimdallGRPCServer) stateSyncHeight(ctx context.Context) (int64, time.Time, error) {
+	if height := queryHeight(ctx); height != 0 {
+		block, err := helper.GetBlock(h.cliCtx, height)
+		if err != nil {
+			logger.Error("Error while fetching block", "height", height, "error", err)
+			return 0, time.Time{}, status.Errorf(codes.OutOfRange, err.Error())
+		}
+
+		return height, block.Block.Time, nil
+	}
+
+	nodeStatus, err := helper.GetNodeStatus(h.cliCtx)
+	if err != nil {
+		logger.Error("Error while fetching node status", "error", err)
+		return 0, time.Time{}, status.Errorf(codes.Unavailable, err.Error())
+	}
+
+	return nodeStatus.SyncInfo.LatestBlockHeight, nodeStatus.SyncInfo.LatestBlockTime, nil
+}
+
+func parseEvents(result []byte) ([]*proto.EventRecord, error) {
+	var events []clerkTypes.EventRecord
 
-	err := json.Unmarshal(result, &events)
+	err := jsoniter.ConfigFastest.Unmarshal(result, &events)
 	if err != nil {
 		logger.Error("Error unmarshalling event record", "error", err)
 		return nil, err
@@ -78,35 +106,16 @@ func parseEvents(result json.RawMessage) ([]*proto.EventRecord, error) {
 	eventRecords := make([]*proto.EventRecord, len(events))
 
 	for i, event := range events {
-		eventTime, err := time.Parse(time.RFC3339, event.RecordTime)
-		if err != nil {
-			logger.Error("Error parsing time", "error", err)
-			return nil, err
-		}
-
 		eventRecords[i] = &proto.EventRecord{
-			ID:       event.Id,
-			Contract: event.Contract,
-			Data:     event.Data,
-			TxHash:   event.TxHash,
+			ID:       event.ID,
+			Contract: event.Contract.String(),
+			Data:     event.Data.String(),
+			TxHash:   event.TxHash.String(),
 			LogIndex: event.LogIndex,
-			ChainID:  event.BorChainId,
-			Time:     timestamppb.New(eventTime),
+			ChainID:  event.ChainID,
+			Time:     timestamppb.New(event.RecordTime),
 		}
 	}
 
 	return eventRecords, nil
 }
-
-func addParamsToEndpoint(endpoint string, params map[string]string) string {
-	u, _ := url.Parse(endpoint)
-	q := u.Query()
-
-	for k, v := range params {
-		q.Set(k, v)
-	}
-
-	u.RawQuery = q.Encode()
-
-	return u.String()
-}
@@ -0,0 +1,463 @@
+package gRPC
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	protoutils "github.com/maticnetwork/polyproto/utils"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/server/gRPC/pb"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	newBlockSubscriber   = "heimdall-grpc-server"
+	subscriberBufferSize = 64
+	maxResubscribeWait   = 30 * time.Second
+)
+
+// blockEvents holds the begin block events of a committed block. Side-tx post
+// handlers run in the begin block, so these carry checkpoint acks, milestones
+// and spans as soon as they are written to the store.
+type blockEvents struct {
+	height int64
+	events []abci.Event
+}
+
+// eventHub fans out new block events from the node to the streaming RPCs
+type eventHub struct {
+	mu          sync.Mutex
+	subscribers map[chan blockEvents]struct{}
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{
+		subscribers: make(map[chan blockEvents]struct{}),
+	}
+}
+
+// subscribe registers a new subscriber for block events
+func (hub *eventHub) subscribe() chan blockEvents {
+	ch := make(chan blockEvents, subscriberBufferSize)
+
+	hub.mu.Lock()
+	hub.subscribers[ch] = struct{}{}
+	hub.mu.Unlock()
+
+	return ch
+}
+
+// unsubscribe removes the subscriber and closes its channel
+func (hub *eventHub) unsubscribe(ch chan blockEvents) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if _, ok := hub.subscribers[ch]; ok {
+		delete(hub.subscribers, ch)
+		close(ch)
+	}
+}
+
+// publish sends the block events to all subscribers. Subscribers which
+// can't keep up are dropped, they have to resubscribe and resume.
+func (hub *eventHub) publish(ev blockEvents) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for ch := range hub.subscribers {
+		select {
+		case ch <- ev:
+		default:
+			delete(hub.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// run subscribes to new blocks on the node and publishes their begin block
+// events until the context is done. It subscribes again if the node closes
+// the subscription.
+func (hub *eventHub) run(ctx context.Context, client rpcclient.Client) {
+	for {
+		eventCh := hub.subscribeNewBlocks(ctx, client)
+		if eventCh == nil {
+			return
+		}
+
+		logger.Info("Subscribed to new blocks for gRPC streams")
+
+		if !hub.publishNewBlocks(ctx, eventCh) {
+			if err := client.UnsubscribeAll(context.Background(), newBlockSubscriber); err != nil {
+				logger.Error("Error while unsubscribing from new blocks", "error", err)
+			}
+
+			return
+		}
+
+		logger.Error("New block subscription closed by the node, subscribing again")
+
+		// clear the closed subscription, so the subscriber can be registered again
+		if err := client.UnsubscribeAll(context.Background(), newBlockSubscriber); err != nil {
+			logger.Debug("Error while unsubscribing from new blocks", "error", err)
+		}
+	}
+}
+
+// subscribeNewBlocks subscribes to new blocks on the node, retrying with a
+// backoff until it succeeds. It returns nil once the context is done.
+func (hub *eventHub) subscribeNewBlocks(ctx context.Context, client rpcclient.Client) <-chan ctypes.ResultEvent {
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+	wait := time.Second
+
+	for {
+		var err error
+
+		if !client.IsRunning() {
+			if err = client.Start(); err != nil {
+				logger.Error("Error while starting node client", "error", err)
+			}
+		}
+
+		if err == nil {
+			var eventCh <-chan ctypes.ResultEvent
+			if eventCh, err = client.Subscribe(ctx, newBlockSubscriber, query); err == nil {
+				return eventCh
+			}
+		}
+
+		logger.Error("Error while subscribing to new blocks, retrying", "wait", wait, "error", err)
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil
+		}
+
+		if wait *= 2; wait > maxResubscribeWait {
+			wait = maxResubscribeWait
+		}
+	}
+}
+
+// publishNewBlocks publishes the begin block events of the new blocks. It
+// returns true if the node closed the subscription, false once the context
+// is done.
+func (hub *eventHub) publishNewBlocks(ctx context.Context, eventCh <-chan ctypes.ResultEvent) bool {
+	for {
+		select {
+		case event, ok := <-eventCh:
+			if !ok {
+				return true
+			}
+
+			if data, ok := event.Data.(tmTypes.EventDataNewBlock); ok {
+				hub.publish(blockEvents{
+					height: data.Block.Height,
+					events: data.ResultBeginBlock.GetEvents(),
+				})
+			}
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// waitForEvent blocks until a block containing an event of the given type is
+// published and returns the matching events
+func waitForEvent(ctx context.Context, ch chan blockEvents, eventType string) (int64, []abci.Event, error) {
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				return 0, nil, status.Error(codes.ResourceExhausted, "subscriber too slow, resubscribe to resume")
+			}
+
+			var matched []abci.Event
+
+			for _, e := range ev.events {
+				if e.Type == eventType {
+					matched = append(matched, e)
+				}
+			}
+
+			if len(matched) > 0 {
+				return ev.height, matched, nil
+			}
+		case <-ctx.Done():
+			return 0, nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// eventAttribute returns the value of the attribute with the given key
+func eventAttribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value)
+		}
+	}
+
+	return ""
+}
+
+func (h *HeimdallGRPCServer) SubscribeCheckpoints(req *pb.SubscribeCheckpointsRequest, stream pb.HeimdallSubscription_SubscribeCheckpointsServer) error {
+	// streams always follow the latest state
+	ctx := stream.Context()
+	if err := requireLatestHeight(ctx); err != nil {
+		return err
+	}
+
+	// subscribe before reading the state, so no ack is missed in between
+	events := h.hub.subscribe()
+	defer h.hub.unsubscribe(events)
+
+	ackCount, _, err := h.fetchAckCount(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := req.FromNumber
+	if next == 0 {
+		next = ackCount + 1
+	}
+
+	for {
+		for ; next <= ackCount; next++ {
+			checkpoint, height, err := h.fetchCheckpointByNumber(ctx, next)
+			if err != nil {
+				logger.Error("Error while fetching checkpoint", "number", next, "error", err)
+				return err
+			}
+
+			if err := stream.Send(&pb.CheckpointEvent{
+				Height: fmt.Sprint(height),
+				Number: next,
+				Result: parseCheckpoint(checkpoint),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if _, _, err := waitForEvent(ctx, events, checkpointTypes.EventTypeCheckpointAck); err != nil {
+			return err
+		}
+
+		if ackCount, _, err = h.fetchAckCount(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (h *HeimdallGRPCServer) SubscribeMilestones(req *pb.SubscribeMilestonesRequest, stream pb.HeimdallSubscription_SubscribeMilestonesServer) error {
+	ctx := stream.Context()
+	if err := requireLatestHeight(ctx); err != nil {
+		return err
+	}
+
+	events := h.hub.subscribe()
+	defer h.hub.unsubscribe(events)
+
+	count, _, err := h.fetchMilestoneCount(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := req.FromCount
+	if next == 0 {
+		next = count + 1
+	}
+
+	for {
+		for ; next <= count; next++ {
+			milestone, height, err := h.fetchMilestoneByNumber(ctx, next)
+			if status.Code(err) == codes.NotFound {
+				// older milestones are pruned from the store
+				logger.Debug("Skipping pruned milestone", "number", next)
+				continue
+			}
+
+			if err != nil {
+				logger.Error("Error while fetching milestone", "number", next, "error", err)
+				return err
+			}
+
+			if err := stream.Send(&pb.MilestoneEvent{
+				Height:      fmt.Sprint(height),
+				Count:       next,
+				MilestoneID: milestone.MilestoneID,
+				Result:      parseMilestone(milestone),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if _, _, err := waitForEvent(ctx, events, checkpointTypes.EventTypeMilestone); err != nil {
+			return err
+		}
+
+		if count, _, err = h.fetchMilestoneCount(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (h *HeimdallGRPCServer) SubscribeNoAckMilestones(req *pb.SubscribeNoAckMilestonesRequest, stream pb.HeimdallSubscription_SubscribeNoAckMilestonesServer) error {
+	ctx := stream.Context()
+	if err := requireLatestHeight(ctx); err != nil {
+		return err
+	}
+
+	events := h.hub.subscribe()
+	defer h.hub.unsubscribe(events)
+
+	// replay the committed blocks since the requested height, the new
+	// blocks published in the meantime are skipped below
+	next := int64(req.FromHeight)
+	if next > 0 {
+		latest, err := h.fetchLatestHeight()
+		if err != nil {
+			return err
+		}
+
+		for ; next <= latest; next++ {
+			blockEvents, err := h.fetchBeginBlockEvents(next)
+			if err != nil {
+				logger.Error("Error while fetching block events", "height", next, "error", err)
+				return err
+			}
+
+			if err := sendNoAckMilestones(stream, next, blockEvents); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		height, noAckEvents, err := waitForEvent(ctx, events, checkpointTypes.EventTypeMilestoneNoAck)
+		if err != nil {
+			return err
+		}
+
+		if height < next {
+			continue
+		}
+
+		if err := sendNoAckMilestones(stream, height, noAckEvents); err != nil {
+			return err
+		}
+	}
+}
+
+// sendNoAckMilestones streams the milestones rejected in the block events
+func sendNoAckMilestones(stream pb.HeimdallSubscription_SubscribeNoAckMilestonesServer, height int64, events []abci.Event) error {
+	for _, event := range events {
+		if event.Type != checkpointTypes.EventTypeMilestoneNoAck {
+			continue
+		}
+
+		startBlock, _ := strconv.ParseUint(eventAttribute(event, checkpointTypes.AttributeKeyStartBlock), 10, 64)
+		endBlock, _ := strconv.ParseUint(eventAttribute(event, checkpointTypes.AttributeKeyEndBlock), 10, 64)
+
+		var proposer [20]byte
+
+		copy(proposer[:], hmTypes.HexToHeimdallAddress(eventAttribute(event, checkpointTypes.AttributeKeyProposer)).Bytes())
+
+		if err := stream.Send(&pb.NoAckMilestoneEvent{
+			Height:      fmt.Sprint(height),
+			MilestoneID: eventAttribute(event, checkpointTypes.AttributeKeyMilestoneID),
+			StartBlock:  startBlock,
+			EndBlock:    endBlock,
+			Proposer:    protoutils.ConvertAddressToH160(proposer),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchLatestHeight returns the height of the latest committed block
+func (h *HeimdallGRPCServer) fetchLatestHeight() (int64, error) {
+	node, err := h.cliCtx.GetNode()
+	if err != nil {
+		return 0, status.Error(codes.Unavailable, err.Error())
+	}
+
+	nodeStatus, err := node.Status()
+	if err != nil {
+		return 0, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return nodeStatus.SyncInfo.LatestBlockHeight, nil
+}
+
+// fetchBeginBlockEvents returns the begin block events of a committed block
+func (h *HeimdallGRPCServer) fetchBeginBlockEvents(height int64) ([]abci.Event, error) {
+	node, err := h.cliCtx.GetNode()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	blockResults, err := node.BlockResults(&height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "block results at height %d: %v", height, err)
+	}
+
+	return blockResults.Results.BeginBlock.GetEvents(), nil
+}
+
+func (h *HeimdallGRPCServer) SubscribeSpans(req *pb.SubscribeSpansRequest, stream pb.HeimdallSubscription_SubscribeSpansServer) error {
+	ctx := stream.Context()
+	if err := requireLatestHeight(ctx); err != nil {
+		return err
+	}
+
+	events := h.hub.subscribe()
+	defer h.hub.unsubscribe(events)
+
+	latestID, _, err := h.fetchLatestSpanID(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := req.FromID
+	if next == 0 {
+		next = latestID + 1
+	}
+
+	for {
+		for ; next <= latestID; next++ {
+			span, height, err := h.fetchSpan(ctx, next)
+			if err != nil {
+				logger.Error("Error while fetching span", "id", next, "error", err)
+				return err
+			}
+
+			if err := stream.Send(&pb.SpanEvent{
+				Height: fmt.Sprint(height),
+				Result: span,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if _, _, err := waitForEvent(ctx, events, borTypes.EventTypeProposeSpan); err != nil {
+			return err
+		}
+
+		if latestID, _, err = h.fetchLatestSpanID(ctx); err != nil {
+			return err
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/slashing/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -70,7 +71,7 @@ func querySigningInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	// get validator signing info
 	signingInfo, found := k.GetValidatorSigningInfo(ctx, params.ValidatorID)
 	if !found {
-		return nil, sdk.ErrInternal("Error while getting validator signing info")
+		return nil, common.ErrNoSigningInfo(k.Codespace())
 	}
 
 	// json record
@@ -226,7 +227,7 @@ func querySlashingSequence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	}
 
 	// get main tx receipt
-	receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(), chainParams.MainchainTxConfirmations)
+	receipt, err := chainParams.GetMainchainTxReceipt(&contractCallerObj, hmTypes.HexToHeimdallHash(params.TxHash).EthHash())
 	if err != nil || receipt == nil {
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
@@ -74,9 +74,9 @@ func SideHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, contr
 	chainParams := params.ChainParams
 
 	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeWaitFrConfirmation, msg.TxHash, err)
 	}
 
 	// get event log for slashed event
@@ -115,9 +115,9 @@ func SideHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, contrac
 	chainParams := params.ChainParams
 
 	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeWaitFrConfirmation, msg.TxHash, err)
 	}
 
 	// get unjail event
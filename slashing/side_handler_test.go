@@ -0,0 +1,118 @@
+package slashing_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/slashing"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Create test suite
+//
+
+// SideHandlerTestSuite integrate test suite context object
+type SideHandlerTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	sideHandler    hmTypes.SideTxHandler
+	contractCaller mocks.IContractCaller
+}
+
+func (suite *SideHandlerTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{})
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.sideHandler = slashing.NewSideTxHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+}
+
+func TestSideHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SideHandlerTestSuite))
+}
+
+//
+// Test cases
+//
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgTickAck() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := app.ChainKeeper.GetParams(ctx)
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	t.Run("NoReceipt", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		txHash := hmTypes.HexToHeimdallHash("no receipt hash")
+		msg := types.NewMsgTickAck(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 1, 1000, txHash, 0, 10)
+
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(common.CodeWaitFrConfirmation), result.Code)
+	})
+
+	t.Run("ReceiptQuorumMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		txHash := hmTypes.HexToHeimdallHash("receipt mismatch hash")
+		msg := types.NewMsgTickAck(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 1, 1000, txHash, 0, 10)
+
+		// endpoints disagree on the receipt
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code, "Side tx handler should fail with receipt mismatch")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgUnjail() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := app.ChainKeeper.GetParams(ctx)
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	t.Run("NoReceipt", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		txHash := hmTypes.HexToHeimdallHash("no receipt hash")
+		msg := types.NewMsgUnjail(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 1, txHash, 0, 10)
+
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(common.CodeWaitFrConfirmation), result.Code)
+	})
+
+	t.Run("ReceiptQuorumMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		txHash := hmTypes.HexToHeimdallHash("receipt mismatch hash")
+		msg := types.NewMsgUnjail(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 1, txHash, 0, 10)
+
+		// endpoints disagree on the receipt
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code, "Side tx handler should fail with receipt mismatch")
+	})
+}
@@ -9,6 +9,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -74,7 +75,7 @@ func handleQuerySigner(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 	// get validator info
 	validator, err := keeper.GetValidatorInfo(ctx, params.SignerAddress)
 	if err != nil {
-		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("Error while getting validator by signer", err.Error()))
+		return nil, common.ErrNoValidator(keeper.Codespace())
 	}
 
 	// json record
@@ -95,7 +96,7 @@ func handleQueryValidator(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	// get validator info
 	validator, ok := keeper.GetValidatorFromValID(ctx, params.ValidatorID)
 	if !ok {
-		return nil, sdk.ErrUnknownRequest("No validator found")
+		return nil, common.ErrNoValidator(keeper.Codespace())
 	}
 
 	// json record
@@ -210,7 +211,7 @@ func handleQueryStakingSequence(ctx sdk.Context, req abci.RequestQuery, keeper K
 	chainParams := keeper.chainKeeper.GetParams(ctx)
 
 	// get main tx receipt
-	receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(), chainParams.MainchainTxConfirmations)
+	receipt, err := chainParams.GetMainchainTxReceipt(contractCallerObj, hmTypes.HexToHeimdallHash(params.TxHash).EthHash())
 	if err != nil || receipt == nil {
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
@@ -73,9 +73,9 @@ func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k K
 	chainParams := params.ChainParams
 
 	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeWaitFrConfirmation, msg.TxHash, err)
 	}
 
 	// decode validator join event
@@ -160,9 +160,9 @@ func SideHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keepe
 	chainParams := params.ChainParams
 
 	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeErrDecodeEvent, msg.TxHash, err)
 	}
 
 	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
@@ -213,9 +213,9 @@ func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Kee
 	chainParams := params.ChainParams
 
 	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeWaitFrConfirmation, msg.TxHash, err)
 	}
 
 	newPubKey := msg.NewSignerPubKey
@@ -274,9 +274,9 @@ func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k K
 	chainParams := params.ChainParams
 
 	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeWaitFrConfirmation, msg.TxHash, err)
 	}
 
 	// decode validator exit
@@ -171,6 +171,28 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 		require.Equal(t, uint32(common.CodeWaitFrConfirmation), result.Code)
 	})
 
+	suite.Run("Receipt quorum mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		msgValJoin := types.NewMsgValidatorJoin(
+			hmTypes.BytesToHeimdallAddress(address.Bytes()),
+			validatorId,
+			uint64(1),
+			sdk.NewInt(1000000000000000000),
+			pubkey,
+			txHash,
+			logIndex,
+			blockNumber.Uint64(),
+			nonce.Uint64(),
+		)
+
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		result := suite.sideHandler(ctx, msgValJoin)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code)
+	})
+
 	suite.Run("No EventLog", func() {
 		suite.contractCaller = mocks.IContractCaller{}
 		txreceipt := &ethTypes.Receipt{
@@ -505,6 +527,18 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
 	})
 
+	suite.Run("Receipt quorum mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		msg := types.NewMsgSignerUpdate(newSigner[0].Signer, uint64(oldSigner.ID), newSigner[0].PubKey, msgTxHash, 0, blockNumber.Uint64(), nonce.Uint64())
+
+		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code)
+	})
+
 	suite.Run("No Eventlog", func() {
 		suite.contractCaller = mocks.IContractCaller{}
 
@@ -727,6 +761,26 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
 	})
 
+	suite.Run("Receipt quorum mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		msg := types.NewMsgValidatorExit(
+			validators[0].Signer,
+			uint64(validators[0].ID),
+			validators[0].EndEpoch,
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64(),
+		)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code)
+	})
+
 	suite.Run("No Eventlog", func() {
 		suite.contractCaller = mocks.IContractCaller{}
 		txreceipt := &ethTypes.Receipt{
@@ -961,6 +1015,24 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgStakeUpdate() {
 		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
 	})
 
+	suite.Run("Receipt quorum mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgStakeUpdate(
+			oldVal.Signer,
+			oldVal.ID.Uint64(),
+			sdk.NewInt(2000000000000000000),
+			msgTxHash,
+			0,
+			blockNumber.Uint64(),
+			nonce.Uint64())
+
+		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code)
+	})
+
 	suite.Run("No Eventlog", func() {
 		suite.contractCaller = mocks.IContractCaller{}
 		msg := types.NewMsgStakeUpdate(
@@ -58,9 +58,9 @@ func SideHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractC
 	chainParams := params.ChainParams
 
 	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	receipt, err := params.GetMainchainTxReceipt(contractCaller, msg.TxHash.EthHash())
 	if err != nil || receipt == nil {
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+		return hmCommon.ErrorSideTxReceipt(k.Logger(ctx), k.Codespace(), common.CodeWaitFrConfirmation, msg.TxHash, err)
 	}
 
 	// get event log for topup
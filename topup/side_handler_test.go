@@ -18,6 +18,7 @@ import (
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/topup"
 	"github.com/maticnetwork/heimdall/topup/types"
@@ -154,6 +155,35 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 		require.Equal(t, uint32(common.CodeWaitFrConfirmation), result.Code)
 	})
 
+	t.Run("ReceiptQuorumMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		logIndex := uint64(10)
+		blockNumber := uint64(599)
+		txHash := hmTypes.HexToHeimdallHash("receipt mismatch hash")
+
+		// set coins
+		coins := simulation.RandomFeeCoins()
+
+		// topup msg
+		msg := types.NewMsgTopup(
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			coins.AmountOf(authTypes.FeeToken),
+			txHash,
+			logIndex,
+			blockNumber,
+		)
+
+		// endpoints disagree on the receipt
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, helper.ErrQuorumMismatch)
+
+		// execute handler
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeReceiptQuorumMismatch), result.Code, "Side tx handler should fail with receipt mismatch")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+
 	t.Run("NoLog", func(t *testing.T) {
 		suite.contractCaller = mocks.IContractCaller{}
 